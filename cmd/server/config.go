@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const envPrefix = "QUASAR_"
+
+// loadConfig fills in every flag that was not given on the command line,
+// first from its QUASAR_* environment variable and then from the config file.
+// Config file keys are the flag names, e.g. "etcd-endpoints".
+func loadConfig(fs *flag.FlagSet, configPath string) error {
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+	fileValues := make(map[string]interface{})
+	if configPath != "" {
+		data, err := os.ReadFile(configPath)
+		if err != nil {
+			return err
+		}
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return err
+		}
+		for name := range fileValues {
+			if fs.Lookup(name) == nil {
+				return fmt.Errorf("unknown option '%s' in config file '%s'", name, configPath)
+			}
+		}
+	}
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || explicit[f.Name] {
+			return
+		}
+		if value, ok := os.LookupEnv(envName(f.Name)); ok {
+			err = fs.Set(f.Name, value)
+		} else if value, ok := fileValues[f.Name]; ok {
+			err = fs.Set(f.Name, configValue(value))
+		}
+	})
+	return err
+}
+
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+func configValue(value interface{}) string {
+	if values, ok := value.([]interface{}); ok {
+		tokens := make([]string, len(values))
+		for i, v := range values {
+			tokens[i] = fmt.Sprint(v)
+		}
+		return strings.Join(tokens, ",")
+	}
+	return fmt.Sprint(value)
+}
+
+func splitList(value string) []string {
+	var tokens []string
+	for _, token := range strings.Split(value, ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
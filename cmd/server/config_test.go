@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	config := "etcd-endpoints: [etcd-0:2379, etcd-1:2379]\netcd-prefix: /file/\netcd-dial-timeout: 3s\nport: 6000\n"
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("QUASAR_ETCD_PREFIX", "/env/")
+	t.Setenv("QUASAR_PORT", "7000")
+
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	port := fs.Int("port", 50051, "")
+	endpoints := fs.String("etcd-endpoints", "localhost:2379", "")
+	prefix := fs.String("etcd-prefix", "", "")
+	dialTimeout := fs.Duration("etcd-dial-timeout", 5*time.Second, "")
+	username := fs.String("etcd-username", "", "")
+	if err := fs.Parse([]string{"-port", "8000"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := loadConfig(fs, path); err != nil {
+		t.Fatal(err)
+	}
+	// Flags take precedence over the environment, which takes precedence
+	// over the config file.
+	if *port != 8000 || *prefix != "/env/" || *dialTimeout != 3*time.Second || *username != "" {
+		t.Errorf("Got port %d, prefix %q, dial timeout %v, username %q", *port, *prefix, *dialTimeout, *username)
+	}
+	if got := splitList(*endpoints); !reflect.DeepEqual(got, []string{"etcd-0:2379", "etcd-1:2379"}) {
+		t.Errorf("Got endpoints %v", got)
+	}
+}
+
+func TestLoadConfigRejectsInvalidFiles(t *testing.T) {
+	for _, config := range []string{"etcd-endpoint: localhost:2379\n", "etcd-dial-timeout: soon\n", "port: [\n"} {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		fs := flag.NewFlagSet("server", flag.ContinueOnError)
+		fs.Int("port", 50051, "")
+		fs.Duration("etcd-dial-timeout", 5*time.Second, "")
+		if err := loadConfig(fs, path); err == nil {
+			t.Errorf("Config %q was accepted", config)
+		}
+	}
+	if err := loadConfig(flag.NewFlagSet("server", flag.ContinueOnError), filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("A missing config file was accepted")
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	if got := splitList(" a:1, ,b:2,"); !reflect.DeepEqual(got, []string{"a:1", "b:2"}) {
+		t.Errorf("Got %v", got)
+	}
+}
@@ -1,18 +1,65 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"time"
 
+	"github.com/jtomic1/config-schema-service/internal/auth"
 	"github.com/jtomic1/config-schema-service/internal/configschema"
+	"github.com/jtomic1/config-schema-service/internal/rbac"
+	"github.com/jtomic1/config-schema-service/internal/repository"
 	pb "github.com/jtomic1/config-schema-service/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var (
-	port = flag.Int("port", 50051, "The server port")
+	port       = flag.Int("port", 50051, "The server port")
+	storage    = flag.String("storage", "etcd", "The storage backend (etcd, memory or bolt)")
+	boltPath   = flag.String("bolt-path", "config-schemas.db", "The database file used by the bolt storage backend")
+	interval   = flag.Duration("health-interval", 10*time.Second, "How often the storage backend's health is checked")
+	configPath = flag.String("config", "", "Path to a YAML config file whose keys are flag names")
+	legacy     = flag.Bool("legacy-status", false, "Report errors in the status and message response fields instead of gRPC status codes")
+	cacheSize  = flag.Int("schema-cache-size", configschema.DefaultSchemaCacheSize, "How many compiled schemas are cached for validation (0 disables the cache)")
+	workers    = flag.Int("validation-workers", runtime.NumCPU(), "How many configurations are validated concurrently by the batch and streaming validation RPCs")
+	metrics    = flag.String("metrics-addr", "", "Address on which metrics are served over HTTP at /debug/vars, e.g. :9090 (disabled if empty)")
+	retention  = flag.Duration("tombstone-retention", configschema.DefaultTombstoneRetention, "How long deleted schemas can be restored (0 allows restoring them at any time)")
+	compatMode = flag.String("default-compatibility", "NONE", "Compatibility mode of schemas without one configured (NONE, BACKWARD, FORWARD, FULL or their _TRANSITIVE variants)")
+
+	tlsCert          = flag.String("tls-cert", "", "Server TLS certificate file (TLS is disabled if empty)")
+	tlsKey           = flag.String("tls-key", "", "Server TLS key file")
+	tlsClientCA      = flag.String("tls-client-ca", "", "CA bundle used to verify client certificates, which enables authentication by client certificate")
+	apiKeysFile      = flag.String("api-keys-file", "", "YAML file of API keys accepted in the x-api-key header")
+	jwksFile         = flag.String("jwks-file", "", "JSON Web Key Set file which bearer tokens in the authorization header are verified against")
+	jwtIssuer        = flag.String("jwt-issuer", "", "Required \"iss\" claim of bearer tokens (not checked if empty)")
+	jwtAudience      = flag.String("jwt-audience", "", "Required \"aud\" claim of bearer tokens (not checked if empty)")
+	jwtUsernameClaim = flag.String("jwt-username-claim", "sub", "Claim of bearer tokens which holds the username")
+	authUserMode     = flag.String("auth-user-mode", "override", "How the user field of authenticated requests is treated (override or validate)")
+	rbacEnabled      = flag.Bool("rbac", false, "Enforce namespace-scoped role bindings (requires authentication)")
+	rbacAdmins       = flag.String("rbac-admins", "", "Comma-separated list of users which are administrators of every namespace")
+	auditEnabled     = flag.Bool("audit", true, "Record every call which modifies schemas or settings in the audit log")
+	auditReads       = flag.Bool("audit-reads", false, "Also record GetConfigSchema and configuration validations in the audit log")
+
+	etcdEndpoints      = flag.String("etcd-endpoints", "localhost:2379", "Comma-separated list of etcd endpoints")
+	etcdDialTimeout    = flag.Duration("etcd-dial-timeout", 5*time.Second, "Timeout for establishing an etcd connection")
+	etcdRequestTimeout = flag.Duration("etcd-request-timeout", 5*time.Second, "Timeout for a single etcd operation")
+	etcdUsername       = flag.String("etcd-username", "", "Username for etcd authentication")
+	etcdPassword       = flag.String("etcd-password", "", "Password for etcd authentication")
+	etcdCert           = flag.String("etcd-cert", "", "Client TLS certificate file for etcd")
+	etcdKey            = flag.String("etcd-key", "", "Client TLS key file for etcd")
+	etcdCACert         = flag.String("etcd-cacert", "", "CA bundle used to verify etcd server certificates")
+	etcdPrefix         = flag.String("etcd-prefix", "", "Root prefix for all keys stored in etcd, e.g. /quasar/")
 )
 
 type configSchemaServer struct {
@@ -21,17 +68,212 @@ type configSchemaServer struct {
 
 func main() {
 	flag.Parse()
+	if err := loadConfig(flag.CommandLine, *configPath); err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	defaultCompatibility, ok := pb.CompatibilityMode_value[*compatMode]
+	if !ok || defaultCompatibility == 0 {
+		log.Fatalf("Unknown compatibility mode '%s'", *compatMode)
+	}
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	configSchemaServer := configschema.NewServer()
+	repo, err := newRepository(*storage)
+	if err != nil {
+		log.Fatalf("Failed to create repository: %v", err)
+	}
+	defer repo.Close()
+
+	configSchemaServer := configschema.NewServer(repo,
+		configschema.WithDefaultCompatibilityMode(pb.CompatibilityMode(defaultCompatibility)),
+		configschema.WithSchemaCacheSize(*cacheSize),
+		configschema.WithValidationWorkers(*workers),
+		configschema.WithAuditReads(*auditReads),
+		configschema.WithTombstoneRetention(*retention),
+	)
+	serverOptions, err := newServerOptions(repo, configSchemaServer)
+	if err != nil {
+		log.Fatalf("Failed to configure server: %v", err)
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
+	if *metrics != "" {
+		go func() {
+			if err := http.ListenAndServe(*metrics, newMetricsHandler(configSchemaServer)); err != nil {
+				log.Printf("Failed to serve metrics: %v", err)
+			}
+		}()
+	}
+
+	healthServer := health.NewServer()
 
 	pb.RegisterConfigSchemaServiceServer(grpcServer, configSchemaServer)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	if checker, ok := repo.(repository.HealthChecker); ok {
+		go watchRepositoryHealth(checker, healthServer, *interval)
+	}
 	log.Printf("Server listening at %v", lis.Addr())
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
+
+// newMetricsHandler serves the schema cache statistics in the expvar format.
+// It does not use http.DefaultServeMux, whose expvar handler also publishes
+// the command line and with it any secrets passed as flags.
+func newMetricsHandler(configSchemaServer *configschema.Server) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/vars", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"schema_cache": configSchemaServer.SchemaCacheStats(),
+		}); err != nil {
+			log.Printf("Failed to write metrics: %v", err)
+		}
+	})
+	return mux
+}
+
+func newServerOptions(repo repository.SchemaRepository, configSchemaServer *configschema.Server) ([]grpc.ServerOption, error) {
+	var serverOptions []grpc.ServerOption
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if *legacy {
+		unaryInterceptors = append(unaryInterceptors, configschema.LegacyStatusInterceptor())
+	}
+	if *tlsCert != "" || *tlsClientCA != "" {
+		tlsConfig, err := newTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	authenticator, err := newAuthenticator()
+	if err != nil {
+		return nil, err
+	} else if authenticator != nil {
+		unaryInterceptors = append(unaryInterceptors, authenticator.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, authenticator.StreamInterceptor())
+	}
+	if *auditEnabled {
+		unaryInterceptors = append(unaryInterceptors, configSchemaServer.AuditInterceptor())
+	}
+	if *rbacEnabled {
+		if authenticator == nil {
+			return nil, fmt.Errorf("-rbac needs an authentication method")
+		}
+		authorizer := rbac.NewAuthorizer(repo, splitList(*rbacAdmins))
+		unaryInterceptors = append(unaryInterceptors, authorizer.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, authorizer.StreamInterceptor())
+	}
+	return append(serverOptions,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	), nil
+}
+
+func newTLSConfig() (*tls.Config, error) {
+	if *tlsCert == "" || *tlsKey == "" {
+		return nil, fmt.Errorf("TLS needs both -tls-cert and -tls-key")
+	}
+	certificate, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if *tlsClientCA != "" {
+		caCert, err := os.ReadFile(*tlsClientCA)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in '%s'", *tlsClientCA)
+		}
+		// Clients may still authenticate with an API key or a bearer token.
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
+// newAuthenticator returns nil if no authentication method is configured, in
+// which case the user field of requests is trusted as before.
+func newAuthenticator() (*auth.Authenticator, error) {
+	var options []auth.Option
+	if *apiKeysFile != "" {
+		keys, err := auth.LoadAPIKeys(*apiKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, auth.WithAPIKeys(keys))
+	}
+	if *jwksFile != "" {
+		keySet, err := auth.LoadJWKS(*jwksFile)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, auth.WithJWKS(keySet, *jwtIssuer, *jwtAudience), auth.WithJWTUsernameClaim(*jwtUsernameClaim))
+	}
+	if *tlsClientCA != "" {
+		options = append(options, auth.WithClientCertificates())
+	}
+	if len(options) == 0 {
+		return nil, nil
+	}
+	switch *authUserMode {
+	case "override":
+		options = append(options, auth.WithUserMode(auth.OverrideUser))
+	case "validate":
+		options = append(options, auth.WithUserMode(auth.ValidateUser))
+	default:
+		return nil, fmt.Errorf("unknown auth user mode '%s'", *authUserMode)
+	}
+	return auth.NewAuthenticator(options...), nil
+}
+
+func newRepository(storage string) (repository.SchemaRepository, error) {
+	switch storage {
+	case "etcd":
+		return repository.NewClient(repository.EtcdConfig{
+			Endpoints:      splitList(*etcdEndpoints),
+			DialTimeout:    *etcdDialTimeout,
+			RequestTimeout: *etcdRequestTimeout,
+			Username:       *etcdUsername,
+			Password:       *etcdPassword,
+			CertFile:       *etcdCert,
+			KeyFile:        *etcdKey,
+			CAFile:         *etcdCACert,
+			KeyPrefix:      *etcdPrefix,
+		})
+	case "memory":
+		return repository.NewMemoryRepository(), nil
+	case "bolt":
+		return repository.NewBoltRepository(*boltPath)
+	default:
+		return nil, fmt.Errorf("unknown storage backend '%s'", storage)
+	}
+}
+
+func watchRepositoryHealth(checker repository.HealthChecker, healthServer *health.Server, interval time.Duration) {
+	healthy := true
+	for {
+		err := checker.CheckHealth()
+		if err != nil && healthy {
+			log.Printf("Storage backend is unhealthy: %v", err)
+		} else if err == nil && !healthy {
+			log.Printf("Storage backend is healthy again")
+		}
+		healthy = err == nil
+		status := healthpb.HealthCheckResponse_SERVING
+		if !healthy {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		healthServer.SetServingStatus("", status)
+		healthServer.SetServingStatus(pb.ConfigSchemaService_ServiceDesc.ServiceName, status)
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/jtomic1/config-schema-service/internal/configschema"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+)
+
+func TestMetricsHandlerServesCacheStatsOnly(t *testing.T) {
+	handler := newMetricsHandler(configschema.NewServer(repository.NewMemoryRepository(), configschema.WithSchemaCacheSize(10)))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	var vars map[string]configschema.SchemaCacheStats
+	if err := json.Unmarshal(rec.Body.Bytes(), &vars); err != nil {
+		t.Fatalf("Got %q: %v", rec.Body.String(), err)
+	}
+	if want := map[string]configschema.SchemaCacheStats{"schema_cache": {Capacity: 10}}; !reflect.DeepEqual(vars, want) {
+		t.Errorf("Got %v, want only the schema cache stats", vars)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Got status %d for /debug/pprof/, want %d", rec.Code, http.StatusNotFound)
+	}
+}
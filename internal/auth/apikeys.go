@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+type apiKeyEntry struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Key      string `json:"key"`
+	SHA256   string `json:"sha256"`
+}
+
+// LoadAPIKeys reads a YAML list of API keys, each with a username, an email
+// and either the key itself or its hex-encoded SHA-256 digest, so that
+// plaintext keys need not be stored on the server.
+func LoadAPIKeys(path string) (map[string]*Principal, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []apiKeyEntry
+	if err := yaml.UnmarshalStrict(data, &entries); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*Principal, len(entries))
+	for _, entry := range entries {
+		if entry.Username == "" {
+			return nil, errors.New("API key without username in '" + path + "'")
+		}
+		digest := strings.ToLower(entry.SHA256)
+		if entry.Key != "" {
+			digest = hashAPIKey(entry.Key)
+		}
+		if len(digest) != sha256.Size*2 {
+			return nil, errors.New("API key of '" + entry.Username + "' needs a key or a SHA-256 digest")
+		}
+		keys[digest] = &Principal{
+			Username: entry.Username,
+			Email:    entry.Email,
+			Method:   MethodAPIKey,
+		}
+	}
+	return keys, nil
+}
+
+func hashAPIKey(key string) string {
+	digest := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(digest[:])
+}
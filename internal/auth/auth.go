@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	APIKeyHeader        = "x-api-key"
+	AuthorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+const (
+	MethodAPIKey            = "api-key"
+	MethodJWT               = "jwt"
+	MethodClientCertificate = "client-certificate"
+)
+
+// Principal is the identity a request has been authenticated as. Email is
+// empty if the credentials do not carry one.
+type Principal struct {
+	Username string
+	Email    string
+	Method   string
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
+
+// UserMode decides what happens to the user field of an authenticated
+// request.
+type UserMode int
+
+const (
+	// OverrideUser replaces the user of the request with the principal.
+	OverrideUser UserMode = iota
+	// ValidateUser rejects requests whose user is not the principal.
+	ValidateUser
+)
+
+type Authenticator struct {
+	apiKeys            map[string]*Principal
+	jwks               *KeySet
+	jwtIssuer          string
+	jwtAudience        string
+	jwtUsernameClaim   string
+	clientCertificates bool
+	userMode           UserMode
+}
+
+type Option func(*Authenticator)
+
+// WithAPIKeys accepts the keys returned by LoadAPIKeys in the x-api-key
+// request header.
+func WithAPIKeys(keys map[string]*Principal) Option {
+	return func(a *Authenticator) {
+		a.apiKeys = keys
+	}
+}
+
+// WithJWKS accepts bearer tokens in the authorization request header which
+// are signed by one of the keys. Empty issuer or audience are not checked.
+func WithJWKS(keys *KeySet, issuer string, audience string) Option {
+	return func(a *Authenticator) {
+		a.jwks = keys
+		a.jwtIssuer = issuer
+		a.jwtAudience = audience
+	}
+}
+
+// WithJWTUsernameClaim sets the claim holding the username. Defaults to "sub".
+func WithJWTUsernameClaim(claim string) Option {
+	return func(a *Authenticator) {
+		a.jwtUsernameClaim = claim
+	}
+}
+
+// WithClientCertificates accepts client certificates verified by the TLS
+// configuration of the server. The username is the subject's common name and
+// the email the first email address SAN.
+func WithClientCertificates() Option {
+	return func(a *Authenticator) {
+		a.clientCertificates = true
+	}
+}
+
+func WithUserMode(mode UserMode) Option {
+	return func(a *Authenticator) {
+		a.userMode = mode
+	}
+}
+
+func NewAuthenticator(opts ...Option) *Authenticator {
+	a := &Authenticator{
+		jwtUsernameClaim: "sub",
+		userMode:         OverrideUser,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Authenticate returns the principal of the credentials sent with a request.
+// Credentials are tried in the order API key, bearer token, client
+// certificate, and the first one present must be valid.
+func (a *Authenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(APIKeyHeader); len(values) > 0 && a.apiKeys != nil {
+		principal, ok := a.apiKeys[hashAPIKey(values[0])]
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "Invalid API key!")
+		}
+		return principal, nil
+	}
+	if values := md.Get(AuthorizationHeader); len(values) > 0 && a.jwks != nil {
+		if !strings.HasPrefix(strings.ToLower(values[0]), bearerPrefix) {
+			return nil, status.Error(codes.Unauthenticated, "Authorization header must contain a bearer token!")
+		}
+		principal, err := a.verifyToken(values[0][len(bearerPrefix):])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "Invalid bearer token: "+err.Error())
+		}
+		return principal, nil
+	}
+	if a.clientCertificates {
+		if principal := clientCertificatePrincipal(ctx); principal != nil {
+			return principal, nil
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "Request carries no credentials!")
+}
+
+func clientCertificatePrincipal(ctx context.Context) *Principal {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	certificate := tlsInfo.State.VerifiedChains[0][0]
+	if certificate.Subject.CommonName == "" {
+		return nil
+	}
+	principal := &Principal{
+		Username: certificate.Subject.CommonName,
+		Method:   MethodClientCertificate,
+	}
+	if len(certificate.EmailAddresses) > 0 {
+		principal.Email = certificate.EmailAddresses[0]
+	}
+	return principal
+}
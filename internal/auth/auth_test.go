@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func incomingContext(pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+func TestAPIKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	keys := "- username: alice\n  email: alice@example.com\n  key: alice-secret\n" +
+		"- username: bob\n  sha256: " + hashAPIKey("bob-secret") + "\n"
+	if err := os.WriteFile(path, []byte(keys), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	apiKeys, err := LoadAPIKeys(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAuthenticator(WithAPIKeys(apiKeys))
+	principal, err := a.Authenticate(incomingContext(APIKeyHeader, "alice-secret"))
+	if err != nil || principal.Username != "alice" || principal.Email != "alice@example.com" || principal.Method != MethodAPIKey {
+		t.Errorf("Got %+v, %v for alice", principal, err)
+	}
+	principal, err = a.Authenticate(incomingContext(APIKeyHeader, "bob-secret"))
+	if err != nil || principal.Username != "bob" {
+		t.Errorf("Got %+v, %v for bob", principal, err)
+	}
+	for _, ctx := range []context.Context{incomingContext(APIKeyHeader, "wrong"), context.Background()} {
+		if _, err := a.Authenticate(ctx); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Got %v, want Unauthenticated", err)
+		}
+	}
+}
+
+func TestLoadAPIKeysRejectsIncompleteEntries(t *testing.T) {
+	for _, keys := range []string{"- email: alice@example.com\n  key: secret\n", "- username: alice\n", "- username: alice\n  sha256: abc\n"} {
+		path := filepath.Join(t.TempDir(), "keys.yaml")
+		if err := os.WriteFile(path, []byte(keys), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadAPIKeys(path); err == nil {
+			t.Errorf("Keys %q were accepted", keys)
+		}
+	}
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, keyID string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if keyID != "" {
+		token.Header["kid"] = keyID
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestJWT(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := `{"keys": [
+		{"kty": "oct", "kid": "hmac", "k": "` + base64.RawURLEncoding.EncodeToString(secret) + `"},
+		{"kty": "RSA", "kid": "rsa", "use": "sig", "n": "` + base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()) +
+		`", "e": "` + base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()) + `"},
+		{"kty": "RSA", "kid": "encryption", "use": "enc", "n": "", "e": ""}
+	]}`
+	keySet, err := ParseJWKS([]byte(jwks))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAuthenticator(WithJWKS(keySet, "issuer", "config-schema-service"))
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub":   "alice",
+			"email": "alice@example.com",
+			"iss":   "issuer",
+			"aud":   "config-schema-service",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		}
+	}
+	authenticate := func(token string) (*Principal, error) {
+		return a.Authenticate(incomingContext(AuthorizationHeader, "Bearer "+token))
+	}
+
+	for _, token := range []string{
+		signToken(t, jwt.SigningMethodHS256, secret, "hmac", valid()),
+		signToken(t, jwt.SigningMethodHS256, secret, "", valid()),
+		signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa", valid()),
+	} {
+		principal, err := authenticate(token)
+		if err != nil || principal.Username != "alice" || principal.Email != "alice@example.com" || principal.Method != MethodJWT {
+			t.Errorf("Got %+v, %v for a valid token", principal, err)
+		}
+	}
+
+	expired := valid()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	withoutExpiry := valid()
+	delete(withoutExpiry, "exp")
+	wrongIssuer := valid()
+	wrongIssuer["iss"] = "other"
+	wrongAudience := valid()
+	wrongAudience["aud"] = "other"
+	withoutSubject := valid()
+	delete(withoutSubject, "sub")
+	invalid := map[string]string{
+		"expired":        signToken(t, jwt.SigningMethodHS256, secret, "hmac", expired),
+		"without expiry": signToken(t, jwt.SigningMethodHS256, secret, "hmac", withoutExpiry),
+		"wrong issuer":   signToken(t, jwt.SigningMethodHS256, secret, "hmac", wrongIssuer),
+		"wrong audience": signToken(t, jwt.SigningMethodHS256, secret, "hmac", wrongAudience),
+		"without sub":    signToken(t, jwt.SigningMethodHS256, secret, "hmac", withoutSubject),
+		"unknown key":    signToken(t, jwt.SigningMethodHS256, secret, "other", valid()),
+		"wrong secret":   signToken(t, jwt.SigningMethodHS256, []byte("another secret of sufficient size"), "hmac", valid()),
+		"unsigned":       signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", valid()),
+		"malformed":      "not-a-token",
+	}
+	for name, token := range invalid {
+		if _, err := authenticate(token); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Got %v for a token %s, want Unauthenticated", err, name)
+		}
+	}
+	if _, err := a.Authenticate(incomingContext(AuthorizationHeader, "Basic YWxpY2U6c2VjcmV0")); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Got %v for basic credentials, want Unauthenticated", err)
+	}
+}
+
+func TestJWTUsernameClaim(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	keySet, err := ParseJWKS([]byte(`{"keys": [{"kty": "oct", "k": "` + base64.RawURLEncoding.EncodeToString(secret) + `"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAuthenticator(WithJWKS(keySet, "", ""), WithJWTUsernameClaim("preferred_username"))
+	token := signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{
+		"sub":                "0f6c1b7e",
+		"preferred_username": "alice",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+	})
+	principal, err := a.Authenticate(incomingContext(AuthorizationHeader, "Bearer "+token))
+	if err != nil || principal.Username != "alice" {
+		t.Errorf("Got %+v, %v, want alice", principal, err)
+	}
+}
+
+func TestParseJWKSRejectsInvalidKeys(t *testing.T) {
+	for _, jwks := range []string{
+		`{"keys": []}`,
+		`{"keys": [{"kty": "EC", "kid": "ec"}]}`,
+		`{"keys": [{"kty": "oct", "kid": "empty", "k": ""}]}`,
+		`{"keys": [{"kty": "RSA", "kid": "rsa", "n": "AQAB"}]}`,
+		`not json`,
+	} {
+		if _, err := ParseJWKS([]byte(jwks)); err == nil {
+			t.Errorf("Key set %s was accepted", jwks)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Methods of the health service are not authenticated, so that load balancers
+// and orchestrators can probe the server without credentials.
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+		principal, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.applyPrincipal(req, principal); err != nil {
+			return nil, err
+		}
+		return handler(NewContext(ctx, principal), req)
+	}
+}
+
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(srv, ss)
+		}
+		principal, err := a.Authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{
+			ServerStream:  ss,
+			ctx:           NewContext(ss.Context(), principal),
+			authenticator: a,
+			principal:     principal,
+		})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx           context.Context
+	authenticator *Authenticator
+	principal     *Principal
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authenticatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authenticator.applyPrincipal(m, s.principal)
+}
+
+// applyPrincipal overrides or validates the user field of a request. If the
+// principal has no email, the email of the request is kept.
+func (a *Authenticator) applyPrincipal(req interface{}, principal *Principal) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	reflected := msg.ProtoReflect()
+	field := reflected.Descriptor().Fields().ByName("user")
+	if field == nil || field.Message() == nil || field.Message().FullName() != (*pb.User)(nil).ProtoReflect().Descriptor().FullName() {
+		return nil
+	}
+	var requestUser *pb.User
+	if reflected.Has(field) {
+		requestUser = reflected.Get(field).Message().Interface().(*pb.User)
+	}
+	if a.userMode == ValidateUser {
+		if requestUser == nil {
+			return nil
+		}
+		if requestUser.GetUsername() != principal.Username || (principal.Email != "" && requestUser.GetEmail() != principal.Email) {
+			return userMismatchError(principal)
+		}
+		return nil
+	}
+	user := &pb.User{
+		Username: principal.Username,
+		Email:    principal.Email,
+	}
+	if user.Email == "" {
+		user.Email = requestUser.GetEmail()
+	}
+	reflected.Set(field, protoreflect.ValueOfMessage(user.ProtoReflect()))
+	return nil
+}
+
+func userMismatchError(principal *Principal) error {
+	st := status.New(codes.PermissionDenied, "User does not match the authenticated principal '"+principal.Username+"'!")
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "USER_MISMATCH",
+		Domain:   "config-schema-service",
+		Metadata: map[string]string{"principal": principal.Username},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
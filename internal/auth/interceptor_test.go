@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryInterceptorAppliesPrincipal(t *testing.T) {
+	apiKeys := map[string]*Principal{hashAPIKey("alice-secret"): {Username: "alice", Method: MethodAPIKey}}
+	info := &grpc.UnaryServerInfo{FullMethod: "/configschema.ConfigSchemaService/GetConfigSchema"}
+	var handled *pb.GetConfigSchemaRequest
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = req.(*pb.GetConfigSchemaRequest)
+		if principal, ok := FromContext(ctx); !ok || principal.Username != "alice" {
+			t.Errorf("Got principal %+v in the handler context", principal)
+		}
+		return &pb.GetConfigSchemaResponse{}, nil
+	}
+	request := func() *pb.GetConfigSchemaRequest {
+		return &pb.GetConfigSchemaRequest{User: &pb.User{Username: "mallory", Email: "mallory@example.com"}}
+	}
+
+	override := NewAuthenticator(WithAPIKeys(apiKeys)).UnaryInterceptor()
+	if _, err := override(incomingContext(APIKeyHeader, "alice-secret"), request(), info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if handled.GetUser().GetUsername() != "alice" || handled.GetUser().GetEmail() != "mallory@example.com" {
+		t.Errorf("Got user %v, want the principal with the requested email", handled.GetUser())
+	}
+	if _, err := override(context.Background(), request(), info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Got %v without credentials, want Unauthenticated", err)
+	}
+	health := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+	if _, err := override(context.Background(), nil, health, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Errorf("Got %v for a health check without credentials", err)
+	}
+
+	validate := NewAuthenticator(WithAPIKeys(apiKeys), WithUserMode(ValidateUser)).UnaryInterceptor()
+	if _, err := validate(incomingContext(APIKeyHeader, "alice-secret"), request(), info, handler); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Got %v for a mismatching user, want PermissionDenied", err)
+	}
+}
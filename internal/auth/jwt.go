@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var signingMethods = []string{"HS256", "HS384", "HS512", "RS256"}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	K       string `json:"k"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+type verificationKey struct {
+	id  string
+	key interface{}
+}
+
+// KeySet holds the keys of a JSON Web Key Set which bearer tokens are
+// verified against. Only symmetric ("oct") and RSA keys are supported.
+type KeySet struct {
+	keys []verificationKey
+}
+
+func LoadJWKS(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJWKS(data)
+}
+
+func ParseJWKS(data []byte) (*KeySet, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, err
+	}
+	keySet := &KeySet{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.verificationKey()
+		if err != nil {
+			return nil, errors.New("invalid key '" + jwk.KeyID + "': " + err.Error())
+		}
+		keySet.keys = append(keySet.keys, verificationKey{id: jwk.KeyID, key: key})
+	}
+	if len(keySet.keys) == 0 {
+		return nil, errors.New("key set contains no signing keys")
+	}
+	return keySet, nil
+}
+
+func (jwk jsonWebKey) verificationKey() (interface{}, error) {
+	switch jwk.KeyType {
+	case "oct":
+		secret, err := base64.RawURLEncoding.DecodeString(jwk.K)
+		if err != nil || len(secret) == 0 {
+			return nil, errors.New("\"k\" must be a non-empty base64url string")
+		}
+		return secret, nil
+	case "RSA":
+		n, nErr := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, eErr := base64.RawURLEncoding.DecodeString(jwk.E)
+		if nErr != nil || eErr != nil || len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("\"n\" and \"e\" must be base64url encoded integers")
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	default:
+		return nil, errors.New("unsupported key type '" + jwk.KeyType + "'")
+	}
+}
+
+// lookup returns the key a token was signed with. Tokens without a "kid"
+// header are accepted if the set holds a single key of the right type.
+func (ks *KeySet) lookup(token *jwt.Token) (interface{}, error) {
+	_, symmetric := token.Method.(*jwt.SigningMethodHMAC)
+	keyID, _ := token.Header["kid"].(string)
+	var found interface{}
+	for _, candidate := range ks.keys {
+		if _, ok := candidate.key.([]byte); ok != symmetric {
+			continue
+		}
+		if keyID != "" && candidate.id == keyID {
+			return candidate.key, nil
+		} else if keyID == "" {
+			if found != nil {
+				return nil, errors.New("token has no \"kid\" header and several keys match")
+			}
+			found = candidate.key
+		}
+	}
+	if found == nil {
+		return nil, errors.New("no key matches the token")
+	}
+	return found, nil
+}
+
+func (a *Authenticator) verifyToken(tokenString string) (*Principal, error) {
+	parserOptions := []jwt.ParserOption{jwt.WithValidMethods(signingMethods), jwt.WithExpirationRequired()}
+	if a.jwtIssuer != "" {
+		parserOptions = append(parserOptions, jwt.WithIssuer(a.jwtIssuer))
+	}
+	if a.jwtAudience != "" {
+		parserOptions = append(parserOptions, jwt.WithAudience(a.jwtAudience))
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, a.jwks.lookup, parserOptions...); err != nil {
+		return nil, err
+	}
+	username, _ := claims[a.jwtUsernameClaim].(string)
+	if username == "" {
+		return nil, errors.New("token has no \"" + a.jwtUsernameClaim + "\" claim")
+	}
+	email, _ := claims["email"].(string)
+	return &Principal{
+		Username: username,
+		Email:    email,
+		Method:   MethodJWT,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package configschema
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log"
+	"strings"
+
+	"github.com/jtomic1/config-schema-service/internal/rbac"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sigs.k8s.io/yaml"
+)
+
+const defaultAuditPageSize = 100
+
+// auditedMethods maps the methods recorded in the audit log onto whether they
+// modify stored data. Methods which do not are only recorded if the server
+// was created WithAuditReads.
+var auditedMethods = map[string]bool{
+	"SaveConfigSchema":       true,
+	"DeleteConfigSchema":     true,
+	"SetCompatibilityMode":   true,
+	"SetRoleBinding":         true,
+	"RestoreConfigSchema":    true,
+	"PurgeConfigSchema":      true,
+	"SetLifecycleState":      true,
+	"GetConfigSchema":        false,
+	"ValidateConfiguration":  false,
+	"ValidateConfigurations": false,
+}
+
+// redactedFields are left out of the request recorded with an audit event.
+// Schemas are recorded by their hash and the user is recorded separately.
+var redactedFields = map[protoreflect.Name]bool{
+	"user":             true,
+	"schema":           true,
+	"candidate_schema": true,
+	"configuration":    true,
+}
+
+// AuditInterceptor records every call of an audited method, whether it
+// succeeded or not, in the audit log of the repository. It must run after
+// authentication, so that the recorded user is the authenticated one. A
+// successful call which modified stored data fails with Internal if its event
+// cannot be stored. No streaming method modifies stored data, so streams are
+// not audited.
+func (s *Server) AuditInterceptor() grpc.UnaryServerInterceptor {
+	servicePrefix := "/" + pb.ConfigSchemaService_ServiceDesc.ServiceName + "/"
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := strings.TrimPrefix(info.FullMethod, servicePrefix)
+		modifies, audited := auditedMethods[method]
+		msg, ok := req.(proto.Message)
+		if !ok || !audited || !strings.HasPrefix(info.FullMethod, servicePrefix) || (!modifies && !s.auditReads) {
+			return handler(ctx, req)
+		}
+		schemaHash := s.getRequestSchemaHash(ctx, req)
+		resp, err := handler(ctx, req)
+		if getResp, ok := resp.(*pb.GetConfigSchemaResponse); ok && getResp.GetSchemaData().GetSchema() != "" {
+			schemaHash = hashSchema(getResp.GetSchemaData().GetSchema())
+		}
+		if auditErr := s.recordAuditEvent(method, msg, resp, err, schemaHash); auditErr != nil {
+			log.Printf("Failed to record audit event for %s: %v", method, auditErr)
+			if modifies && err == nil {
+				return nil, status.Error(codes.Internal, "The change was applied, but could not be recorded in the audit log!")
+			}
+		}
+		return resp, err
+	}
+}
+
+// getRequestSchemaHash returns the hash of the schema a request saves,
+// deletes, restores or purges.
+func (s *Server) getRequestSchemaHash(ctx context.Context, req interface{}) string {
+	switch in := req.(type) {
+	case *pb.SaveConfigSchemaRequest:
+		return hashSchema(in.GetSchema())
+	case *pb.DeleteConfigSchemaRequest:
+		if ok, _ := validators.IsDeleteSchemaRequestValid(in); !ok {
+			return ""
+		}
+		schemaData, err := s.repo.GetConfigSchema(ctx, getConfigSchemaKey(in.GetSchemaDetails()))
+		if err != nil || schemaData == nil {
+			return ""
+		}
+		return hashSchema(schemaData.GetSchema())
+	case *pb.RestoreConfigSchemaRequest:
+		return s.getDeletedSchemaHash(ctx, in.GetSchemaDetails())
+	case *pb.PurgeConfigSchemaRequest:
+		return s.getDeletedSchemaHash(ctx, in.GetSchemaDetails())
+	default:
+		return ""
+	}
+}
+
+func (s *Server) getDeletedSchemaHash(ctx context.Context, schemaDetails *pb.ConfigSchemaDetails) string {
+	if ok, _ := validators.AreSchemaDetailsValid(schemaDetails, validators.VersionExact); !ok {
+		return ""
+	}
+	_, schemaData, err := s.getDeletedSchema(ctx, schemaDetails)
+	if err != nil || schemaData.GetSchema() == "" {
+		return ""
+	}
+	return hashSchema(schemaData.GetSchema())
+}
+
+func (s *Server) recordAuditEvent(method string, req proto.Message, resp interface{}, err error, schemaHash string) error {
+	st := status.Convert(err)
+	event := &pb.AuditEvent{
+		Time:         timestamppb.Now(),
+		Method:       method,
+		Namespaces:   rbac.RequestNamespaces(req),
+		Request:      auditRequest(req),
+		Status:       int32(st.Code()),
+		Message:      st.Message(),
+		SchemaSha256: schemaHash,
+	}
+	if userReq, ok := req.(interface{ GetUser() *pb.User }); ok {
+		event.User = userReq.GetUser()
+	}
+	if detailsReq, ok := req.(interface {
+		GetSchemaDetails() *pb.ConfigSchemaDetails
+	}); ok {
+		event.SchemaDetails = detailsReq.GetSchemaDetails()
+	}
+	if messageResp, ok := resp.(interface{ GetMessage() string }); ok && err == nil {
+		event.Message = messageResp.GetMessage()
+	}
+	return s.repo.AppendAuditEvent(context.Background(), event)
+}
+
+func auditRequest(req proto.Message) string {
+	redacted := proto.Clone(req)
+	redact(redacted.ProtoReflect())
+	encoded, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(redacted)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+func redact(msg protoreflect.Message) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case redactedFields[field.Name()]:
+			msg.Clear(field)
+		case field.Kind() != protoreflect.MessageKind || field.IsMap():
+		case field.IsList():
+			for i := 0; i < value.List().Len(); i++ {
+				redact(value.List().Get(i).Message())
+			}
+		default:
+			redact(value.Message())
+		}
+		return true
+	})
+}
+
+// hashSchema hashes the JSON form of a schema, which is how schemas are
+// stored, so a saved schema has the same hash whether it was sent as YAML or
+// JSON and when it is read back.
+func hashSchema(schema string) string {
+	normalized, err := yaml.YAMLToJSON([]byte(schema))
+	if err != nil {
+		normalized = []byte(schema)
+	}
+	digest := sha256.Sum256(normalized)
+	return hex.EncodeToString(digest[:])
+}
+
+func (s *Server) ListAuditEvents(ctx context.Context, in *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	_, err := validators.IsListAuditEventsRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	after, err := base64.RawURLEncoding.DecodeString(in.GetPageToken())
+	if err != nil {
+		return nil, invalidArgumentError(&validators.FieldError{
+			Field:   "page_token",
+			Message: "Page token is invalid!",
+		})
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultAuditPageSize
+	}
+	filter := repository.AuditFilter{
+		Namespace: in.GetNamespace(),
+		Username:  in.GetUsername(),
+		After:     string(after),
+		Limit:     pageSize + 1,
+	}
+	if in.GetStartTime() != nil {
+		filter.From = in.GetStartTime().AsTime()
+	}
+	if in.GetEndTime() != nil {
+		filter.To = in.GetEndTime().AsTime()
+	}
+	events, err := s.repo.ListAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, repositoryError(err, "Error while retrieving audit events!")
+	}
+	var nextPageToken string
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		nextPageToken = encodePageToken(events[len(events)-1].GetId())
+	}
+	return &pb.ListAuditEventsResponse{
+		Status:        0,
+		Message:       "Audit events retrieved successfully!",
+		Events:        events,
+		NextPageToken: nextPageToken,
+	}, nil
+}
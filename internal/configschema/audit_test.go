@@ -0,0 +1,195 @@
+package configschema
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func callAudited(t *testing.T, s *Server, method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	t.Helper()
+	return s.AuditInterceptor()(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: fullMethod(method)}, handler)
+}
+
+func TestAuditInterceptorRecordsEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	s := NewServer(repo)
+	details := testDetails("team", "db", "v1.0.0")
+
+	save := &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: details, Schema: hostSchema}
+	saveHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.SaveConfigSchema(ctx, req.(*pb.SaveConfigSchemaRequest))
+	}
+	if _, err := callAudited(t, s, "SaveConfigSchema", save, saveHandler); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := callAudited(t, s, "SaveConfigSchema", save, saveHandler); err == nil {
+		t.Fatal("Saved the same version twice")
+	}
+	get := &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: details}
+	if _, err := callAudited(t, s, "GetConfigSchema", get, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.GetConfigSchema(ctx, req.(*pb.GetConfigSchemaRequest))
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reads are only recorded WithAuditReads.
+	events, err := repo.ListAuditEvents(ctx, repository.AuditFilter{})
+	if err != nil || len(events) != 2 {
+		t.Fatalf("Got events %v, %v, want both saves", events, err)
+	}
+	saved, failed := events[0], events[1]
+	if saved.GetMethod() != "SaveConfigSchema" || saved.GetStatus() != int32(codes.OK) || saved.GetUser().GetUsername() != testUser.GetUsername() ||
+		len(saved.GetNamespaces()) != 1 || saved.GetNamespaces()[0] != "team" || saved.GetSchemaDetails().GetVersion() != "v1.0.0" {
+		t.Errorf("Got event %v of a successful save", saved)
+	}
+	if saved.GetSchemaSha256() != hashSchema(hostSchema) {
+		t.Errorf("Got schema hash %s, want %s", saved.GetSchemaSha256(), hashSchema(hostSchema))
+	}
+	if strings.Contains(saved.GetRequest(), "schema\"") || strings.Contains(saved.GetRequest(), testUser.GetEmail()) {
+		t.Errorf("Got unredacted request %s", saved.GetRequest())
+	}
+	if failed.GetStatus() == int32(codes.OK) || failed.GetMessage() == "" {
+		t.Errorf("Got event %v of a failed save", failed)
+	}
+
+	s = NewServer(repo, WithAuditReads(true))
+	if _, err := callAudited(t, s, "GetConfigSchema", get, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.GetConfigSchema(ctx, req.(*pb.GetConfigSchemaRequest))
+	}); err != nil {
+		t.Fatal(err)
+	}
+	events, err = repo.ListAuditEvents(ctx, repository.AuditFilter{})
+	if err != nil || len(events) != 3 || events[2].GetMethod() != "GetConfigSchema" || events[2].GetSchemaSha256() != hashSchema(hostSchema) {
+		t.Errorf("Got events %v, %v, want the read to be recorded", events, err)
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	s := NewServer(repo)
+	start := time.Now().Add(-time.Hour)
+	var ids []string
+	for i, username := range []string{"alice", "bob", "alice", "alice", "bob"} {
+		event := &pb.AuditEvent{
+			Time:       timestamppb.New(start.Add(time.Duration(i) * time.Minute)),
+			Method:     "SaveConfigSchema",
+			Namespaces: []string{"team"},
+			User:       &pb.User{Username: username, Email: username + "@example.com"},
+		}
+		if err := repo.AppendAuditEvent(ctx, event); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, event.GetId())
+	}
+	listAll := func(t *testing.T, in *pb.ListAuditEventsRequest) ([]string, int) {
+		t.Helper()
+		var listed []string
+		pages := 0
+		for {
+			resp, err := s.ListAuditEvents(ctx, in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pages++
+			for _, event := range resp.GetEvents() {
+				listed = append(listed, event.GetId())
+			}
+			if resp.GetNextPageToken() == "" {
+				return listed, pages
+			}
+			in.PageToken = resp.GetNextPageToken()
+		}
+	}
+
+	cases := []struct {
+		name  string
+		in    *pb.ListAuditEventsRequest
+		want  []string
+		pages int
+	}{
+		{"all", &pb.ListAuditEventsRequest{}, ids, 1},
+		{"paged", &pb.ListAuditEventsRequest{PageSize: 2}, ids, 3},
+		{"exact pages", &pb.ListAuditEventsRequest{PageSize: 5}, ids, 1},
+		{"user", &pb.ListAuditEventsRequest{Username: "alice", PageSize: 1}, []string{ids[0], ids[2], ids[3]}, 3},
+		{"time", &pb.ListAuditEventsRequest{
+			StartTime: timestamppb.New(start.Add(time.Minute)),
+			EndTime:   timestamppb.New(start.Add(4 * time.Minute)),
+			PageSize:  2,
+		}, ids[1:4], 2},
+		{"user and time", &pb.ListAuditEventsRequest{Username: "bob", StartTime: timestamppb.New(start.Add(2 * time.Minute))}, ids[4:], 1},
+		{"other namespace", &pb.ListAuditEventsRequest{Namespace: "other"}, nil, 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.in.User = testUser
+			got, pages := listAll(t, c.in)
+			if strings.Join(got, ",") != strings.Join(c.want, ",") || pages != c.pages {
+				t.Errorf("Got events %v in %d pages, want %v in %d pages", got, pages, c.want, c.pages)
+			}
+		})
+	}
+
+	invalid := []*pb.ListAuditEventsRequest{
+		{User: testUser, PageToken: "not base64!"},
+		{User: testUser, PageSize: -1},
+		{User: testUser, StartTime: timestamppb.New(start), EndTime: timestamppb.New(start)},
+	}
+	for _, in := range invalid {
+		if _, err := s.ListAuditEvents(ctx, in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Got %v for %v, want InvalidArgument", err, in)
+		}
+	}
+}
+
+// unavailableAuditLog stores everything but audit events.
+type unavailableAuditLog struct {
+	repository.SchemaRepository
+}
+
+func (r unavailableAuditLog) AppendAuditEvent(ctx context.Context, event *pb.AuditEvent) error {
+	return errors.New("audit log unavailable")
+}
+
+func TestAuditInterceptorFailsUnrecordedChanges(t *testing.T) {
+	s := NewServer(unavailableAuditLog{repository.NewMemoryRepository()}, WithAuditReads(true))
+	interceptor := s.AuditInterceptor()
+	details := testDetails("team", "db", "v1.0.0")
+
+	save := &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: details, Schema: "type: object\n"}
+	_, err := interceptor(context.Background(), save, &grpc.UnaryServerInfo{FullMethod: fullMethod("SaveConfigSchema")},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.SaveConfigSchema(ctx, req.(*pb.SaveConfigSchemaRequest))
+		})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("Save with an unavailable audit log: got %v, want Internal", err)
+	}
+
+	// Failed changes and reads keep their own outcome.
+	_, err = interceptor(context.Background(), save, &grpc.UnaryServerInfo{FullMethod: fullMethod("SaveConfigSchema")},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.SaveConfigSchema(ctx, req.(*pb.SaveConfigSchemaRequest))
+		})
+	if status.Code(err) == codes.Internal || status.Code(err) == codes.OK {
+		t.Fatalf("Save of an existing version: got %v", err)
+	}
+	get := &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: details}
+	resp, err := interceptor(context.Background(), get, &grpc.UnaryServerInfo{FullMethod: fullMethod("GetConfigSchema")},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.GetConfigSchema(ctx, req.(*pb.GetConfigSchemaRequest))
+		})
+	if err != nil || resp.(*pb.GetConfigSchemaResponse).GetSchemaData() == nil {
+		t.Fatalf("Get with an unavailable audit log: got %v, %v", resp, err)
+	}
+}
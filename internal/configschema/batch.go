@@ -0,0 +1,164 @@
+package configschema
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ValidateConfigurations(ctx context.Context, in *pb.ValidateConfigurationsRequest) (*pb.ValidateConfigurationsResponse, error) {
+	_, err := validators.IsValidateConfigurationsRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	results := make([]*pb.ValidationResult, len(in.GetItems()))
+	var wg sync.WaitGroup
+	for i, item := range in.GetItems() {
+		if err := s.acquireWorker(ctx); err != nil {
+			wg.Wait()
+			return nil, repositoryError(err, "")
+		}
+		wg.Add(1)
+		go func(i int, item *pb.ValidationItem) {
+			defer wg.Done()
+			defer s.releaseWorker()
+			results[i] = s.validateItem(ctx, in.GetUser(), int32(i), item)
+		}(i, item)
+	}
+	wg.Wait()
+	response := &pb.ValidateConfigurationsResponse{
+		Status:  0,
+		Message: "Configurations validated successfully!",
+		Results: results,
+	}
+	for _, result := range results {
+		switch {
+		case result.GetStatus() != int32(codes.OK):
+			response.FailedCount++
+		case result.GetIsValid():
+			response.ValidCount++
+		default:
+			response.InvalidCount++
+		}
+	}
+	return response, nil
+}
+
+// ValidateConfigurationStream validates the items of every received request
+// as soon as a worker is available and streams back each result when it is
+// ready, so results may arrive out of order. Results are matched to items by
+// id or by index, which counts items across the whole stream.
+//
+// At most as many items as there are workers are in flight on a stream, from
+// being received until their result is sent. A client which does not read its
+// results therefore stops its own stream from receiving items, while workers
+// are released as soon as an item is validated.
+func (s *Server) ValidateConfigurationStream(stream pb.ConfigSchemaService_ValidateConfigurationStreamServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	inFlight := make(chan struct{}, cap(s.workers))
+	results := make(chan *pb.ValidationResult, cap(inFlight))
+	sendErr := make(chan error, 1)
+	go func() {
+		for result := range results {
+			if err := stream.Send(result); err != nil {
+				sendErr <- err
+				cancel()
+				for range results {
+				}
+				return
+			}
+			<-inFlight
+		}
+		sendErr <- nil
+	}()
+	var wg sync.WaitGroup
+	recvErr := s.receiveItems(ctx, stream, inFlight, results, &wg)
+	wg.Wait()
+	close(results)
+	if err := <-sendErr; err != nil {
+		return err
+	}
+	return recvErr
+}
+
+func (s *Server) receiveItems(ctx context.Context, stream pb.ConfigSchemaService_ValidateConfigurationStreamServer, inFlight chan<- struct{}, results chan<- *pb.ValidationResult, wg *sync.WaitGroup) error {
+	var index int32
+	for {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if _, err := validators.IsValidateConfigurationsRequestValid(in); err != nil {
+			return invalidArgumentError(err)
+		}
+		for _, item := range in.GetItems() {
+			select {
+			case inFlight <- struct{}{}:
+			case <-ctx.Done():
+				return repositoryError(ctx.Err(), "")
+			}
+			if err := s.acquireWorker(ctx); err != nil {
+				return repositoryError(err, "")
+			}
+			wg.Add(1)
+			go func(index int32, user *pb.User, item *pb.ValidationItem) {
+				defer wg.Done()
+				result := s.validateItem(ctx, user, index, item)
+				s.releaseWorker()
+				results <- result
+			}(index, in.GetUser(), item)
+			index++
+		}
+	}
+}
+
+func (s *Server) acquireWorker(ctx context.Context) error {
+	select {
+	case s.workers <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Server) releaseWorker() {
+	<-s.workers
+}
+
+// validateItem validates a single item like ValidateConfiguration, reporting
+// failures in the result instead of failing the whole request.
+func (s *Server) validateItem(ctx context.Context, user *pb.User, index int32, item *pb.ValidationItem) *pb.ValidationResult {
+	response, err := s.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{
+		User:          user,
+		SchemaDetails: item.GetSchemaDetails(),
+		Configuration: item.GetConfiguration(),
+	})
+	if err != nil {
+		st := status.Convert(err)
+		return &pb.ValidationResult{
+			Id:      item.GetId(),
+			Index:   index,
+			Status:  int32(st.Code()),
+			Message: st.Message(),
+		}
+	}
+	return &pb.ValidationResult{
+		Id:              item.GetId(),
+		Index:           index,
+		Status:          response.GetStatus(),
+		Message:         response.GetMessage(),
+		IsValid:         response.GetIsValid(),
+		Errors:          response.GetErrors(),
+		ResolvedVersion: response.GetResolvedVersion(),
+		Warnings:        response.GetWarnings(),
+	}
+}
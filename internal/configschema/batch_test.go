@@ -0,0 +1,191 @@
+package configschema
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func dialBufconn(t *testing.T, listener *bufconn.Listener) pb.ConfigSchemaServiceClient {
+	t.Helper()
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// Fixed windows keep the transport from buffering the results of a
+		// client which does not read them.
+		grpc.WithInitialWindowSize(1<<16),
+		grpc.WithInitialConnWindowSize(1<<16),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewConfigSchemaServiceClient(conn)
+}
+
+func TestValidateConfigurations(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository(), WithValidationWorkers(2))
+	saveTestSchema(t, s, testDetails("team", "db", "v1.0.0"), hostPortSchema, false)
+	resp, err := s.ValidateConfigurations(ctx, &pb.ValidateConfigurationsRequest{
+		User: testUser,
+		Items: []*pb.ValidationItem{
+			{Id: "valid", SchemaDetails: testDetails("team", "db", "latest"), Configuration: "port: 80"},
+			{Id: "invalid", SchemaDetails: testDetails("team", "db", "v1.0.0"), Configuration: "port: http"},
+			{Id: "missing", SchemaDetails: testDetails("team", "db", "v2.0.0"), Configuration: "port: 80"},
+			{Id: "malformed", SchemaDetails: testDetails("team", "db", "v1.0.0")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetValidCount() != 1 || resp.GetInvalidCount() != 1 || resp.GetFailedCount() != 2 {
+		t.Errorf("Got %d valid, %d invalid and %d failed items", resp.GetValidCount(), resp.GetInvalidCount(), resp.GetFailedCount())
+	}
+	want := []struct {
+		id     string
+		status codes.Code
+		valid  bool
+	}{
+		{"valid", codes.OK, true},
+		{"invalid", codes.OK, false},
+		{"missing", codes.NotFound, false},
+		{"malformed", codes.InvalidArgument, false},
+	}
+	for i, result := range resp.GetResults() {
+		if result.GetId() != want[i].id || result.GetIndex() != int32(i) || codes.Code(result.GetStatus()) != want[i].status || result.GetIsValid() != want[i].valid {
+			t.Errorf("Got result %v, want %+v", result, want[i])
+		}
+	}
+	if resp.GetResults()[0].GetResolvedVersion() != "v1.0.0" {
+		t.Errorf("Got resolved version %q, want v1.0.0", resp.GetResults()[0].GetResolvedVersion())
+	}
+
+	if _, err := s.ValidateConfigurations(ctx, &pb.ValidateConfigurationsRequest{User: testUser}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Got %v for an empty batch, want InvalidArgument", err)
+	}
+}
+
+func TestValidateConfigurationStream(t *testing.T) {
+	s := NewServer(repository.NewMemoryRepository(), WithValidationWorkers(2))
+	saveTestSchema(t, s, testDetails("team", "db", "v1.0.0"), hostPortSchema, false)
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterConfigSchemaServiceServer(grpcServer, s)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	stream, err := dialBufconn(t, listener).ValidateConfigurationStream(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	details := testDetails("team", "db", "v1.0.0")
+	for _, items := range [][]*pb.ValidationItem{
+		{{Configuration: "port: 80", SchemaDetails: details}, {Configuration: "port: http", SchemaDetails: details}},
+		{{Id: "third", Configuration: "port: 443", SchemaDetails: details}},
+	} {
+		if err := stream.Send(&pb.ValidateConfigurationsRequest{User: testUser, Items: items}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	var results []*pb.ValidationResult
+	for {
+		result, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, result)
+	}
+	// Results arrive in completion order, but indexes count across requests.
+	sort.Slice(results, func(i, j int) bool { return results[i].GetIndex() < results[j].GetIndex() })
+	if len(results) != 3 || !results[0].GetIsValid() || results[1].GetIsValid() || results[2].GetId() != "third" || results[2].GetIndex() != 2 {
+		t.Errorf("Got results %v", results)
+	}
+}
+
+// TestValidateConfigurationStreamSlowClient checks that a client which streams
+// items without reading its results holds up neither other streams nor
+// batches, even with a single worker.
+func TestValidateConfigurationStreamSlowClient(t *testing.T) {
+	s := NewServer(repository.NewMemoryRepository(), WithValidationWorkers(1))
+	details := testDetails("team", "db", "v1.0.0")
+	saveTestSchema(t, s, details, portSchema, false)
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterConfigSchemaServiceServer(grpcServer, s)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	items := make([]*pb.ValidationItem, validators.MaxBatchSize)
+	for i := range items {
+		items[i] = &pb.ValidationItem{
+			Id:            "item-" + strconv.Itoa(i),
+			SchemaDetails: details,
+			Configuration: "port: " + strconv.Itoa(i),
+		}
+	}
+	slowCtx, cancelSlow := context.WithCancel(context.Background())
+	defer cancelSlow()
+	slow, err := dialBufconn(t, listener).ValidateConfigurationStream(slowCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for i := 0; i < 20; i++ {
+			if slow.Send(&pb.ValidateConfigurationsRequest{User: testUser, Items: items}) != nil {
+				return
+			}
+		}
+	}()
+	// Give the slow stream time to fill up its flow control window.
+	time.Sleep(500 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := dialBufconn(t, listener)
+	batch, err := client.ValidateConfigurations(ctx, &pb.ValidateConfigurationsRequest{User: testUser, Items: items[:10]})
+	if err != nil {
+		t.Fatalf("Batch failed while a stream was not read: %v", err)
+	} else if batch.GetValidCount() != 10 {
+		t.Fatalf("Got %d valid configurations, want 10", batch.GetValidCount())
+	}
+	stream, err := client.ValidateConfigurationStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Send(&pb.ValidateConfigurationsRequest{User: testUser, Items: items[:10]}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		result, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Stream failed while another stream was not read: %v", err)
+		} else if !result.GetIsValid() {
+			t.Fatalf("Got result %v", result)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package configschema
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const DefaultSchemaCacheSize = 1000
+
+type SchemaCacheStats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+	Entries   int    `json:"entries"`
+	Capacity  int    `json:"capacity"`
+}
+
+// compiledSchema is a cached schema. The creation time tells apart the
+// versions of a draft saved more than once, and referencedTimes holds the
+// creation times of the schemas it was compiled with.
+type compiledSchema struct {
+	key             string
+	document        interface{}
+	compiled        *gojsonschema.Schema
+	creationTime    time.Time
+	state           pb.LifecycleState
+	stateReason     string
+	referencedTimes map[string]time.Time
+}
+
+// schemaCache is an LRU cache of compiled schemas keyed by schema key. Only
+// drafts can be overwritten, so entries are removed when a draft is saved,
+// when the lifecycle state changes and on delete. Changes made through other
+// servers are caught by checking the stored metadata on every hit. A cache
+// with a capacity of zero or less stores nothing.
+type schemaCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	stats    SchemaCacheStats
+}
+
+func newSchemaCache(capacity int) *schemaCache {
+	return &schemaCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *schemaCache) get(key string) (*compiledSchema, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.capacity <= 0 {
+		return nil, false
+	}
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(element)
+	return element.Value.(*compiledSchema), true
+}
+
+func (c *schemaCache) add(entry *compiledSchema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.capacity <= 0 {
+		return
+	}
+	if element, ok := c.entries[entry.key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*compiledSchema).key)
+		c.stats.Evictions++
+	}
+}
+
+func (c *schemaCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.order.Remove(element)
+		delete(c.entries, key)
+	}
+}
+
+func (c *schemaCache) getStats() SchemaCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = c.order.Len()
+	stats.Capacity = c.capacity
+	return stats
+}
@@ -0,0 +1,178 @@
+package configschema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSchemaCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newSchemaCache(2)
+	for _, key := range []string{"a", "b"} {
+		cache.add(&compiledSchema{key: key})
+	}
+	if _, ok := cache.get("a"); !ok {
+		t.Fatal("a is not cached")
+	}
+	cache.add(&compiledSchema{key: "c"})
+	if _, ok := cache.get("b"); ok {
+		t.Error("b was kept although it was used least recently")
+	}
+	cache.remove("c")
+	if _, ok := cache.get("c"); ok {
+		t.Error("c was kept after removing it")
+	}
+	want := SchemaCacheStats{Hits: 1, Misses: 2, Evictions: 1, Entries: 1, Capacity: 2}
+	if stats := cache.getStats(); stats != want {
+		t.Errorf("Got stats %+v, want %+v", stats, want)
+	}
+
+	disabled := newSchemaCache(0)
+	disabled.add(&compiledSchema{key: "a"})
+	if _, ok := disabled.get("a"); ok || disabled.getStats() != (SchemaCacheStats{}) {
+		t.Errorf("A disabled cache stored %+v", disabled.getStats())
+	}
+}
+
+func TestValidateConfigurationUsesCache(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository(), WithSchemaCacheSize(10))
+	details := testDetails("team", "db", "v1.0.0")
+	saveTestSchema(t, s, details, hostPortSchema, false)
+	validate := func() {
+		t.Helper()
+		resp, err := s.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{User: testUser, SchemaDetails: details, Configuration: "port: http"})
+		if err != nil || resp.GetIsValid() || resp.GetErrors()[0].GetSchemaPath() != "#/properties/port/type" {
+			t.Fatalf("Got %v, %v, want port to be invalid", resp, err)
+		}
+	}
+	validate()
+	validate()
+	if stats := s.SchemaCacheStats(); stats.Hits != 1 || stats.Misses != 1 || stats.Entries != 1 {
+		t.Errorf("Got stats %+v, want one miss followed by a hit", stats)
+	}
+	if _, err := s.DeleteConfigSchema(ctx, &pb.DeleteConfigSchemaRequest{User: testUser, SchemaDetails: details}); err != nil {
+		t.Fatal(err)
+	}
+	if stats := s.SchemaCacheStats(); stats.Entries != 0 {
+		t.Errorf("Got stats %+v, want the deleted schema to be evicted", stats)
+	}
+}
+
+// TestSchemaCacheFollowsOtherServers checks that a server does not validate
+// against a cached schema which another server sharing its repository has
+// changed.
+func TestSchemaCacheFollowsOtherServers(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	validating, changing := NewServer(repo), NewServer(repo)
+	draft := testDetails("team", "db", "v1.0.0")
+	published := testDetails("team", "db", "v2.0.0")
+	validate := func(details *pb.ConfigSchemaDetails) (*pb.ValidateConfigurationResponse, error) {
+		return validating.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{
+			User:          testUser,
+			SchemaDetails: details,
+			Configuration: "port: 5432",
+		})
+	}
+
+	if _, err := changing.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{
+		User:          testUser,
+		SchemaDetails: draft,
+		Schema:        "properties:\n  port:\n    type: integer\n",
+		Draft:         true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := validate(draft); err != nil || !resp.GetIsValid() {
+		t.Fatalf("Got %v, %v, want a valid configuration", resp, err)
+	}
+	if _, err := changing.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{
+		User:          testUser,
+		SchemaDetails: draft,
+		Schema:        "properties:\n  port:\n    type: string\n",
+		Draft:         true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := validate(draft); err != nil || resp.GetIsValid() {
+		t.Fatalf("Got %v, %v, want the overwritten draft to be used", resp, err)
+	}
+
+	if _, err := changing.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{
+		User:          testUser,
+		SchemaDetails: published,
+		Schema:        "properties:\n  port:\n    type: integer\n",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := validate(published); err != nil || !resp.GetIsValid() {
+		t.Fatalf("Got %v, %v, want a valid configuration", resp, err)
+	}
+	if _, err := changing.SetLifecycleState(ctx, &pb.SetLifecycleStateRequest{
+		User:          testUser,
+		SchemaDetails: published,
+		State:         pb.LifecycleState_RETIRED,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := validate(published); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Got %v, want the retired schema to be rejected", err)
+	}
+	if _, err := changing.DeleteConfigSchema(ctx, &pb.DeleteConfigSchemaRequest{User: testUser, SchemaDetails: published}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := validate(published); status.Code(err) != codes.NotFound {
+		t.Fatalf("Got %v, want the deleted schema to be missing", err)
+	}
+}
+
+// TestDraftsCannotBeReferenced checks that a published schema cannot depend on
+// a draft, which would change the published schema when saved again.
+func TestDraftsCannotBeReferenced(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository())
+	saveTestSchema(t, s, testDetails("n", "a", "v1.0.0"), "definitions:\n  P:\n    type: integer\n", true)
+	_, err := s.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("n", "b", "v1.0.0"),
+		Schema:        "properties:\n  port:\n    $ref: quasar://n/a/v1.0.0#/definitions/P\n",
+	})
+	if status.Code(err) != codes.FailedPrecondition || errorReason(err) != "REFERENCE_IS_DRAFT" {
+		t.Fatalf("Got %v referencing a draft, want REFERENCE_IS_DRAFT", err)
+	}
+}
+
+// TestSchemaCacheChecksReferences checks that a cached schema is compiled
+// again once a schema it references is no longer stored as it was compiled.
+func TestSchemaCacheChecksReferences(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository(), WithSchemaCacheSize(10))
+	saveTestSchema(t, s, testDetails("common", "port", "v1.0.0"), "definitions:\n  port:\n    type: integer\n", false)
+	saveTestSchema(t, s, testDetails("team", "db", "v1.0.0"), "properties:\n  port:\n    $ref: quasar://common/port/v1.0.0#/definitions/port\n", false)
+	validate := func() {
+		t.Helper()
+		resp, err := s.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{User: testUser, SchemaDetails: testDetails("team", "db", "v1.0.0"), Configuration: "port: 5"})
+		if err != nil || !resp.GetIsValid() {
+			t.Fatalf("Got %v, %v, want a valid configuration", resp, err)
+		}
+	}
+	validate()
+	cached, ok := s.cache.get("team/db/v1.0.0")
+	if !ok {
+		t.Fatal("team/db/v1.0.0 is not cached")
+	}
+	// Pretend common/port/v1.0.0 was saved again since it was compiled.
+	stored := cached.referencedTimes["common/port/v1.0.0"]
+	cached.referencedTimes["common/port/v1.0.0"] = time.Time{}
+	validate()
+	recompiled, _ := s.cache.get("team/db/v1.0.0")
+	if recompiled == cached || !recompiled.referencedTimes["common/port/v1.0.0"].Equal(stored) {
+		t.Errorf("Got %+v, want the schema to be compiled again", recompiled)
+	}
+}
@@ -0,0 +1,170 @@
+package configschema
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jtomic1/config-schema-service/internal/schemadiff"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"golang.org/x/mod/semver"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+type compatibilityViolation struct {
+	version string
+	change  schemadiff.Change
+}
+
+func (s *Server) SetCompatibilityMode(ctx context.Context, in *pb.SetCompatibilityModeRequest) (*pb.SetCompatibilityModeResponse, error) {
+	_, err := validators.IsSetCompatibilityModeRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	if err := s.repo.SetCompatibilityMode(ctx, getConfigSchemaPrefix(in.GetSchemaDetails()), in.GetMode()); err != nil {
+		return nil, repositoryError(err, "Error while saving compatibility mode!")
+	}
+	return &pb.SetCompatibilityModeResponse{
+		Status:  0,
+		Message: "Compatibility mode saved successfully!",
+	}, nil
+}
+
+func (s *Server) GetCompatibilityMode(ctx context.Context, in *pb.GetCompatibilityModeRequest) (*pb.GetCompatibilityModeResponse, error) {
+	_, err := validators.IsGetCompatibilityModeRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	mode, err := s.getCompatibilityMode(ctx, in.GetSchemaDetails())
+	if err != nil {
+		return nil, repositoryError(err, "Error while retrieving compatibility mode!")
+	}
+	return &pb.GetCompatibilityModeResponse{
+		Status:  0,
+		Message: "Compatibility mode retrieved successfully!",
+		Mode:    mode,
+	}, nil
+}
+
+// getCompatibilityMode returns the mode configured for the schema, falling
+// back to the server default when none is configured.
+func (s *Server) getCompatibilityMode(ctx context.Context, schemaDetails *pb.ConfigSchemaDetails) (pb.CompatibilityMode, error) {
+	mode, err := s.repo.GetCompatibilityMode(ctx, getConfigSchemaPrefix(schemaDetails))
+	if err != nil {
+		return mode, err
+	}
+	if mode == pb.CompatibilityMode_COMPATIBILITY_MODE_UNSPECIFIED {
+		return s.defaultCompatibility, nil
+	}
+	return mode, nil
+}
+
+// getPrecedingVersions returns the stored versions of the schema, provided
+// schemaDetails succeeds all of them. Otherwise it returns nil and leaves the
+// out-of-order version for the repository to reject.
+func (s *Server) getPrecedingVersions(ctx context.Context, schemaDetails *pb.ConfigSchemaDetails) ([]*pb.ConfigSchemaDetails, error) {
+	stored, err := s.repo.GetSchemaDetailsByPrefix(ctx, getConfigSchemaPrefix(schemaDetails)+"/")
+	if err != nil || len(stored) == 0 {
+		return nil, err
+	}
+	switch semver.Compare(schemaDetails.GetVersion(), stored[len(stored)-1].GetVersion()) {
+	case 1:
+		return stored, nil
+	case 0:
+		// Overwriting a draft compares it with the versions preceding it.
+		metadata, err := s.repo.GetConfigSchemaMetadata(ctx, getConfigSchemaKey(schemaDetails))
+		if err != nil || metadata.GetState() != pb.LifecycleState_DRAFT {
+			return nil, err
+		}
+		return stored[:len(stored)-1], nil
+	default:
+		return nil, nil
+	}
+}
+
+// compareWithStored returns the changes from the stored version to schema,
+// or nil if the stored version has disappeared in the meantime.
+func (s *Server) compareWithStored(ctx context.Context, storedDetails *pb.ConfigSchemaDetails, schema string) ([]schemadiff.Change, error) {
+	schemaData, err := s.repo.GetConfigSchema(ctx, getConfigSchemaKey(storedDetails))
+	if err != nil || schemaData == nil {
+		return nil, err
+	}
+	return schemadiff.Compare(schemaData.GetSchema(), schema)
+}
+
+// checkCompatibility compares schema with the latest of the preceding
+// versions, or with all of them for transitive modes.
+func (s *Server) checkCompatibility(ctx context.Context, schemaDetails *pb.ConfigSchemaDetails, schema string, preceding []*pb.ConfigSchemaDetails) (pb.CompatibilityMode, []compatibilityViolation, error) {
+	mode, err := s.getCompatibilityMode(ctx, schemaDetails)
+	if err != nil || mode == pb.CompatibilityMode_NONE || len(preceding) == 0 {
+		return mode, nil, err
+	}
+	if !isTransitive(mode) {
+		preceding = preceding[len(preceding)-1:]
+	}
+	var violations []compatibilityViolation
+	for i := len(preceding) - 1; i >= 0; i-- {
+		changes, err := s.compareWithStored(ctx, preceding[i], schema)
+		if err != nil {
+			return mode, nil, err
+		}
+		for _, change := range changes {
+			if (checksBackward(mode) && change.Narrowing) || (checksForward(mode) && change.Widening) {
+				violations = append(violations, compatibilityViolation{
+					version: preceding[i].GetVersion(),
+					change:  change,
+				})
+			}
+		}
+	}
+	return mode, violations, nil
+}
+
+func isTransitive(mode pb.CompatibilityMode) bool {
+	return mode == pb.CompatibilityMode_BACKWARD_TRANSITIVE ||
+		mode == pb.CompatibilityMode_FORWARD_TRANSITIVE ||
+		mode == pb.CompatibilityMode_FULL_TRANSITIVE
+}
+
+func checksBackward(mode pb.CompatibilityMode) bool {
+	return mode == pb.CompatibilityMode_BACKWARD ||
+		mode == pb.CompatibilityMode_BACKWARD_TRANSITIVE ||
+		mode == pb.CompatibilityMode_FULL ||
+		mode == pb.CompatibilityMode_FULL_TRANSITIVE
+}
+
+func checksForward(mode pb.CompatibilityMode) bool {
+	return mode == pb.CompatibilityMode_FORWARD ||
+		mode == pb.CompatibilityMode_FORWARD_TRANSITIVE ||
+		mode == pb.CompatibilityMode_FULL ||
+		mode == pb.CompatibilityMode_FULL_TRANSITIVE
+}
+
+func incompatibleSchemaError(mode pb.CompatibilityMode, violations []compatibilityViolation) error {
+	descriptions, preconditionFailure := newPreconditionFailure(violations)
+	return newStatusError(codes.FailedPrecondition,
+		"Schema is not "+mode.String()+" compatible! "+descriptions,
+		preconditionFailure,
+		&errdetails.ErrorInfo{
+			Reason:   "SCHEMA_INCOMPATIBLE",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"compatibility_mode": mode.String()},
+		},
+	)
+}
+
+func newPreconditionFailure(violations []compatibilityViolation) (string, *errdetails.PreconditionFailure) {
+	descriptions := make([]string, len(violations))
+	preconditionFailure := &errdetails.PreconditionFailure{}
+	for i, violation := range violations {
+		subject := violation.version + violation.change.Path
+		descriptions[i] = subject + ": " + violation.change.Description
+		preconditionFailure.Violations = append(preconditionFailure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        violation.change.Kind,
+			Subject:     subject,
+			Description: violation.change.Description,
+		})
+	}
+	return strings.Join(descriptions, "; "), preconditionFailure
+}
@@ -2,9 +2,15 @@ package configschema
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"runtime"
+	"sort"
+	"time"
 
 	"github.com/jtomic1/config-schema-service/internal/repository"
 	"github.com/jtomic1/config-schema-service/internal/validators"
+	"github.com/jtomic1/config-schema-service/internal/versions"
 	pb "github.com/jtomic1/config-schema-service/proto"
 	"github.com/xeipuuv/gojsonschema"
 	"golang.org/x/mod/semver"
@@ -13,6 +19,58 @@ import (
 
 type Server struct {
 	pb.UnimplementedConfigSchemaServiceServer
+	repo                 repository.SchemaRepository
+	defaultCompatibility pb.CompatibilityMode
+	cache                *schemaCache
+	workers              chan struct{}
+	auditReads           bool
+	tombstoneRetention   time.Duration
+}
+
+type ServerOption func(*Server)
+
+// WithDefaultCompatibilityMode sets the compatibility mode of schemas that
+// have none configured. Defaults to NONE.
+func WithDefaultCompatibilityMode(mode pb.CompatibilityMode) ServerOption {
+	return func(s *Server) {
+		s.defaultCompatibility = mode
+	}
+}
+
+// WithSchemaCacheSize limits how many compiled schemas are kept in memory for
+// ValidateConfiguration. A size of zero disables the cache.
+func WithSchemaCacheSize(size int) ServerOption {
+	return func(s *Server) {
+		s.cache = newSchemaCache(size)
+	}
+}
+
+// WithValidationWorkers limits how many configurations are validated
+// concurrently by ValidateConfigurations and ValidateConfigurationStream
+// across all requests. Defaults to the number of CPUs.
+func WithValidationWorkers(workers int) ServerOption {
+	return func(s *Server) {
+		if workers > 0 {
+			s.workers = make(chan struct{}, workers)
+		}
+	}
+}
+
+// WithAuditReads makes AuditInterceptor also record calls which only read
+// schemas, such as GetConfigSchema and ValidateConfiguration.
+func WithAuditReads(enabled bool) ServerOption {
+	return func(s *Server) {
+		s.auditReads = enabled
+	}
+}
+
+// WithTombstoneRetention limits how long deleted schemas can be restored. A
+// retention of zero allows restoring them at any time. Defaults to
+// DefaultTombstoneRetention.
+func WithTombstoneRetention(retention time.Duration) ServerOption {
+	return func(s *Server) {
+		s.tombstoneRetention = retention
+	}
 }
 
 type ConfigSchemaRequest interface {
@@ -21,8 +79,23 @@ type ConfigSchemaRequest interface {
 	GetVersion() string
 }
 
-func NewServer() *Server {
-	return &Server{}
+// NewServer returns a server storing schemas in repo, which is required.
+func NewServer(repo repository.SchemaRepository, opts ...ServerOption) *Server {
+	s := &Server{
+		repo:                 repo,
+		defaultCompatibility: pb.CompatibilityMode_NONE,
+		cache:                newSchemaCache(DefaultSchemaCacheSize),
+		workers:              make(chan struct{}, runtime.NumCPU()),
+		tombstoneRetention:   DefaultTombstoneRetention,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Server) SchemaCacheStats() SchemaCacheStats {
+	return s.cache.getStats()
 }
 
 func getConfigSchemaKey(req ConfigSchemaRequest) string {
@@ -36,39 +109,49 @@ func getConfigSchemaPrefix(req ConfigSchemaRequest) string {
 func (s *Server) SaveConfigSchema(ctx context.Context, in *pb.SaveConfigSchemaRequest) (*pb.SaveConfigSchemaResponse, error) {
 	_, err := validators.IsSaveSchemaRequestValid(in)
 	if err != nil {
-		return &pb.SaveConfigSchemaResponse{
-			Status:  3,
-			Message: err.Error(),
-		}, nil
+		return nil, invalidArgumentError(err)
 	}
-	repoClient, err := repository.NewClient()
-	defer repoClient.Close()
+	referenced, _, err := s.loadReferences(ctx, in.GetSchema())
 	if err != nil {
-		return &pb.SaveConfigSchemaResponse{
-			Status:  13,
-			Message: "Error while instantiating database client!",
-		}, nil
+		return nil, repositoryError(err, "Error while retrieving referenced schemas!")
+	} else if len(referenced) > 0 {
+		if _, err := compileSchema(in.GetSchema(), referenced); err != nil {
+			return nil, invalidArgumentError(&validators.FieldError{
+				Field:   "schema",
+				Message: err.Error(),
+			})
+		}
 	}
-	latestVersion, err := repoClient.GetLatestVersionByPrefix(getConfigSchemaPrefix(in.GetSchemaDetails()))
+	preceding, err := s.getPrecedingVersions(ctx, in.GetSchemaDetails())
 	if err != nil {
-		return &pb.SaveConfigSchemaResponse{
-			Status:  13,
-			Message: err.Error(),
-		}, nil
+		return nil, repositoryError(err, "Error while checking schema compatibility!")
+	}
+	mode, violations, err := s.checkCompatibility(ctx, in.GetSchemaDetails(), in.GetSchema(), preceding)
+	if err != nil {
+		return nil, repositoryError(err, "Error while checking schema compatibility!")
+	} else if len(violations) > 0 {
+		return nil, incompatibleSchemaError(mode, violations)
 	}
-	if latestVersion != "" && semver.Compare(in.GetSchemaDetails().GetVersion(), latestVersion) != 1 {
-		return &pb.SaveConfigSchemaResponse{
-			Status:  3,
-			Message: "Provided version is not latest! Please provide a version that succeeds '" + latestVersion + "'!",
-		}, nil
+	if len(preceding) > 0 {
+		latest := preceding[len(preceding)-1]
+		required, breaking, err := s.checkVersionBump(ctx, latest, in.GetSchemaDetails().GetVersion(), in.GetSchema())
+		if err != nil {
+			return nil, repositoryError(err, "Error while checking schema compatibility!")
+		} else if len(breaking) > 0 {
+			return nil, versionBumpError(latest.GetVersion(), required, breaking)
+		}
 	}
-	err = repoClient.SaveConfigSchema(getConfigSchemaKey(in.GetSchemaDetails()), in.GetUser(), in.GetSchema())
+	state := pb.LifecycleState_PUBLISHED
+	if in.GetDraft() {
+		state = pb.LifecycleState_DRAFT
+	}
+	key := getConfigSchemaKey(in.GetSchemaDetails())
+	err = s.repo.SaveConfigSchema(ctx, key, in.GetUser(), in.GetSchema(), state)
 	if err != nil {
-		return &pb.SaveConfigSchemaResponse{
-			Status:  13,
-			Message: err.Error(),
-		}, nil
+		return nil, repositoryError(err, "Error while saving schema!")
 	}
+	// A draft may have been overwritten.
+	s.cache.remove(key)
 	return &pb.SaveConfigSchemaResponse{
 		Status:  0,
 		Message: "Schema saved successfully!",
@@ -78,169 +161,277 @@ func (s *Server) SaveConfigSchema(ctx context.Context, in *pb.SaveConfigSchemaRe
 func (s *Server) GetConfigSchema(ctx context.Context, in *pb.GetConfigSchemaRequest) (*pb.GetConfigSchemaResponse, error) {
 	_, err := validators.IsGetSchemaRequestValid(in)
 	if err != nil {
-		return &pb.GetConfigSchemaResponse{
-			Status:     3,
-			Message:    err.Error(),
-			SchemaData: nil,
-		}, nil
-	}
-	repoClient, err := repository.NewClient()
-	defer repoClient.Close()
-	if err != nil {
-		return &pb.GetConfigSchemaResponse{
-			Status:     13,
-			Message:    "Error while instantiating database client!",
-			SchemaData: nil,
-		}, nil
+		return nil, invalidArgumentError(err)
 	}
-	key := getConfigSchemaKey(in.GetSchemaDetails())
-	schemaData, err := repoClient.GetConfigSchema(key)
-	if err != nil {
-		return &pb.GetConfigSchemaResponse{
-			Status:     13,
-			Message:    "Error while retrieving schema!",
-			SchemaData: nil,
-		}, nil
+	schemaDetails, schemaData, err := s.getResolvedSchema(ctx, in.GetSchemaDetails())
+	var notFoundErr *repository.SchemaNotFoundError
+	if in.GetIncludeDeleted() && errors.As(err, &notFoundErr) {
+		schemaDetails, schemaData, err = s.getDeletedSchema(ctx, in.GetSchemaDetails())
 	}
-	var message string
-	if schemaData == nil {
-		message = "No schema with key '" + key + "' found!"
-	} else {
-		message = "Schema retrieved successfully!"
+	if err != nil {
+		return nil, repositoryError(err, "Error while retrieving schema!")
 	}
 	return &pb.GetConfigSchemaResponse{
-		Status:     0,
-		Message:    message,
-		SchemaData: schemaData,
+		Status:          0,
+		Message:         "Schema retrieved successfully!",
+		SchemaData:      schemaData,
+		ResolvedVersion: schemaDetails.GetVersion(),
 	}, nil
 }
 
 func (s *Server) DeleteConfigSchema(ctx context.Context, in *pb.DeleteConfigSchemaRequest) (*pb.DeleteConfigSchemaResponse, error) {
 	_, err := validators.IsDeleteSchemaRequestValid(in)
 	if err != nil {
-		return &pb.DeleteConfigSchemaResponse{
-			Status:  3,
-			Message: err.Error(),
-		}, nil
+		return nil, invalidArgumentError(err)
 	}
-	repoClient, err := repository.NewClient()
-	defer repoClient.Close()
-	if err != nil {
-		return &pb.DeleteConfigSchemaResponse{
-			Status:  13,
-			Message: "Error while instantiating database client!",
-		}, nil
-	}
-	if err := repoClient.DeleteConfigSchema(getConfigSchemaKey(in.GetSchemaDetails())); err != nil {
-		return &pb.DeleteConfigSchemaResponse{
-			Status:  3,
-			Message: err.Error(),
-		}, nil
-	} else {
-		return &pb.DeleteConfigSchemaResponse{
-			Status:  0,
-			Message: "Schema deleted successfully!",
-		}, nil
+	key := getConfigSchemaKey(in.GetSchemaDetails())
+	if err := s.repo.DeleteConfigSchema(ctx, key, in.GetUser()); err != nil {
+		return nil, repositoryError(err, "Error while deleting schema!")
 	}
+	s.cache.remove(key)
+	return &pb.DeleteConfigSchemaResponse{
+		Status:  0,
+		Message: "Schema deleted successfully!",
+	}, nil
 }
 
 func (s *Server) ValidateConfiguration(ctx context.Context, in *pb.ValidateConfigurationRequest) (*pb.ValidateConfigurationResponse, error) {
-	isValid, err := validators.IsValidateConfigurationRequestValid(in)
+	_, err := validators.IsValidateConfigurationRequestValid(in)
 	if err != nil {
-		return &pb.ValidateConfigurationResponse{
-			Status:  3,
-			Message: err.Error(),
-			IsValid: isValid,
-		}, nil
+		return nil, invalidArgumentError(err)
 	}
-	repoClient, err := repository.NewClient()
-	defer repoClient.Close()
+	schemaDetails, err := s.resolveVersion(ctx, in.GetSchemaDetails())
 	if err != nil {
-		return &pb.ValidateConfigurationResponse{
-			Status:  13,
-			Message: "Error while instantiating database client!",
-			IsValid: false,
-		}, nil
+		return nil, repositoryError(err, "Error while retrieving schema!")
 	}
-	key := getConfigSchemaKey(in.GetSchemaDetails())
-	schemaData, err := repoClient.GetConfigSchema(key)
+	schema, err := s.getCompiledSchema(ctx, getConfigSchemaKey(schemaDetails))
 	if err != nil {
-		return &pb.ValidateConfigurationResponse{
-			Status:  13,
-			Message: "Error while instantiating database client!",
-			IsValid: false,
-		}, nil
-	} else if schemaData == nil {
-		return &pb.ValidateConfigurationResponse{
-			Status:  3,
-			Message: "No schema with key '" + key + "' found!",
-			IsValid: false,
-		}, nil
+		return nil, repositoryError(err, "Error while retrieving schema!")
+	} else if schema.state == pb.LifecycleState_RETIRED {
+		return nil, schemaRetiredError(schema)
 	}
-	validationResult, err := validateConfiguration(in.GetConfiguration(), schemaData.GetSchema())
+	validationResult, err := validateConfiguration(in.GetConfiguration(), schema.compiled)
 	if err != nil {
-		return &pb.ValidateConfigurationResponse{
-			Status:  3,
+		return nil, invalidArgumentError(&validators.FieldError{
+			Field:   "configuration",
 			Message: "Error while validating schema!",
-			IsValid: false,
-		}, nil
+		})
 	}
 	var message string
-	if validationResult.Valid() && message == "" {
+	if validationResult.Valid() {
 		message = "The configuration is valid!"
 	} else {
 		message = validationResult.Errors()[0].String()
 	}
 
 	return &pb.ValidateConfigurationResponse{
-		Status:  0,
-		Message: message,
-		IsValid: validationResult.Valid(),
+		Status:          0,
+		Message:         message,
+		IsValid:         validationResult.Valid(),
+		Errors:          newValidationErrors(validationResult, schema.document, in.GetConfiguration()),
+		ResolvedVersion: schemaDetails.GetVersion(),
+		Warnings:        stateWarnings(schema),
 	}, nil
 }
 
-func validateConfiguration(configuration string, schema string) (*gojsonschema.Result, error) {
-	configurationJson, err := yaml.YAMLToJSON([]byte(configuration))
+// getResolvedSchema resolves the version of schemaDetails and returns the
+// schema stored under it.
+func (s *Server) getResolvedSchema(ctx context.Context, schemaDetails *pb.ConfigSchemaDetails) (*pb.ConfigSchemaDetails, *pb.ConfigSchemaData, error) {
+	resolved, err := s.resolveVersion(ctx, schemaDetails)
+	if err != nil {
+		return nil, nil, err
+	}
+	key := getConfigSchemaKey(resolved)
+	schemaData, err := s.repo.GetConfigSchema(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	} else if schemaData == nil {
+		return nil, nil, &repository.SchemaNotFoundError{Key: key}
+	}
+	return resolved, schemaData, nil
+}
+
+// resolveVersion replaces "latest", "latest-stable" or a version range in
+// schemaDetails with the highest stored version it selects. Drafts and
+// retired versions are only used if requested by exact version.
+func (s *Server) resolveVersion(ctx context.Context, schemaDetails *pb.ConfigSchemaDetails) (*pb.ConfigSchemaDetails, error) {
+	if versions.IsExact(schemaDetails.GetVersion()) {
+		return schemaDetails, nil
+	}
+	selector, err := versions.ParseSelector(schemaDetails.GetVersion())
 	if err != nil {
 		return nil, err
 	}
-	schemaJson, err := yaml.YAMLToJSON([]byte(schema))
+	stored, err := s.repo.GetSchemaDetailsByPrefix(ctx, getConfigSchemaPrefix(schemaDetails)+"/")
 	if err != nil {
 		return nil, err
 	}
-	configLoader := gojsonschema.NewStringLoader(string(configurationJson))
-	schemaLoader := gojsonschema.NewStringLoader(string(schemaJson))
-	result, err := gojsonschema.Validate(schemaLoader, configLoader)
+	storedVersions := make([]string, len(stored))
+	for i, details := range stored {
+		storedVersions[i] = details.GetVersion()
+	}
+	for {
+		resolved := selector.Resolve(storedVersions)
+		if resolved == "" {
+			return nil, &repository.SchemaNotFoundError{Key: getConfigSchemaKey(schemaDetails)}
+		}
+		resolvedDetails := &pb.ConfigSchemaDetails{
+			Namespace:  schemaDetails.GetNamespace(),
+			SchemaName: schemaDetails.GetSchemaName(),
+			Version:    resolved,
+		}
+		metadata, err := s.repo.GetConfigSchemaMetadata(ctx, getConfigSchemaKey(resolvedDetails))
+		if err != nil {
+			return nil, err
+		} else if metadata != nil && isSelectable(metadata.GetState()) {
+			return resolvedDetails, nil
+		}
+		storedVersions = removeVersion(storedVersions, resolved)
+	}
+}
+
+// getCompiledSchema returns the schema stored under key, compiled together
+// with the schemas it references. Cache hits are checked against the stored
+// metadata of the schema and of the schemas it references, since other
+// servers sharing the repository may have deleted them, saved a draft again
+// or changed the lifecycle state.
+func (s *Server) getCompiledSchema(ctx context.Context, key string) (*compiledSchema, error) {
+	if cached, ok := s.cache.get(key); ok {
+		metadata, err := s.repo.GetConfigSchemaMetadata(ctx, key)
+		if err != nil {
+			return nil, err
+		} else if metadata == nil {
+			s.cache.remove(key)
+			return nil, &repository.SchemaNotFoundError{Key: key}
+		}
+		current, err := s.referencesAreCurrent(ctx, cached)
+		if err != nil {
+			return nil, err
+		} else if current && metadata.GetCreationTime().AsTime().Equal(cached.creationTime) {
+			schema := *cached
+			schema.state = metadata.GetState()
+			schema.stateReason = metadata.GetStateReason()
+			return &schema, nil
+		}
+	}
+	schemaData, err := s.repo.GetConfigSchema(ctx, key)
+	if err != nil {
+		return nil, err
+	} else if schemaData == nil {
+		return nil, &repository.SchemaNotFoundError{Key: key}
+	}
+	referenced, referencedTimes, err := s.loadReferences(ctx, schemaData.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := compileSchema(schemaData.GetSchema(), referenced)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := yaml.Unmarshal([]byte(schemaData.GetSchema()), &document); err != nil {
+		return nil, err
+	}
+	schema := &compiledSchema{
+		key:             key,
+		document:        document,
+		compiled:        compiled,
+		creationTime:    schemaData.GetCreationTime().AsTime(),
+		state:           schemaData.GetState(),
+		stateReason:     schemaData.GetStateReason(),
+		referencedTimes: referencedTimes,
+	}
+	s.cache.add(schema)
+	return schema, nil
+}
+
+// referencesAreCurrent reports whether every schema a cached schema was
+// compiled with is still stored as it was. Schemas saved before drafts could
+// no longer be referenced may still reference a draft which was saved again.
+func (s *Server) referencesAreCurrent(ctx context.Context, cached *compiledSchema) (bool, error) {
+	for referenced, creationTime := range cached.referencedTimes {
+		metadata, err := s.repo.GetConfigSchemaMetadata(ctx, referenced)
+		if err != nil {
+			return false, err
+		} else if metadata == nil || !metadata.GetCreationTime().AsTime().Equal(creationTime) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func validateConfiguration(configuration string, schema *gojsonschema.Schema) (*gojsonschema.Result, error) {
+	configurationJson, err := yaml.YAMLToJSON([]byte(configuration))
 	if err != nil {
 		return nil, err
-	} else {
-		return result, nil
 	}
+	return schema.Validate(gojsonschema.NewStringLoader(string(configurationJson)))
 }
 
 func (s *Server) GetConfigSchemaVersions(ctx context.Context, in *pb.ConfigSchemaVersionsRequest) (*pb.ConfigSchemaVersionsResponse, error) {
 	_, err := validators.IsGetConfigSchemaVersionsValid(in)
 	if err != nil {
-		return &pb.ConfigSchemaVersionsResponse{
-			Status:  3,
-			Message: err.Error(),
-		}, nil
+		return nil, invalidArgumentError(err)
 	}
-	repoClient, err := repository.NewClient()
-	defer repoClient.Close()
+	pageStart, err := decodePageToken(in.GetPageToken())
 	if err != nil {
-		return &pb.ConfigSchemaVersionsResponse{
-			Status:  13,
-			Message: "Error while instantiating database client!",
-		}, nil
+		return nil, invalidArgumentError(err)
+	}
+	versionRange, err := versions.ParseRange(in.GetVersionRange())
+	if err != nil {
+		return nil, invalidArgumentError(&validators.FieldError{
+			Field:   "version_range",
+			Message: err.Error(),
+		})
 	}
 	key := getConfigSchemaPrefix(in.GetSchemaDetails())
-	schemaVersions, err := repoClient.GetSchemasByPrefix(key)
+	// Schemas are loaded once the page is known.
+	schemas, err := s.repo.GetSchemaMetadataByPrefix(ctx, key+"/")
 	if err != nil {
-		return &pb.ConfigSchemaVersionsResponse{
-			Status:  13,
-			Message: "Error while retrieving schema!",
-		}, nil
+		return nil, repositoryError(err, "Error while retrieving schema!")
+	}
+	deleted := make(map[*pb.ConfigSchema]bool)
+	if in.GetIncludeDeleted() {
+		deletedSchemas, err := s.repo.GetDeletedSchemasByPrefix(ctx, key+"/")
+		if err != nil {
+			return nil, repositoryError(err, "Error while retrieving schema!")
+		}
+		for _, schema := range deletedSchemas {
+			deleted[schema] = true
+		}
+		schemas = append(schemas, deletedSchemas...)
+		sort.Slice(schemas, func(i, j int) bool {
+			return semver.Compare(schemas[i].GetSchemaDetails().GetVersion(), schemas[j].GetSchemaDetails().GetVersion()) == -1
+		})
+	}
+	states := make(map[pb.LifecycleState]bool)
+	for _, state := range in.GetStates() {
+		states[state] = true
+	}
+	var schemaVersions []*pb.ConfigSchema
+	var nextPageToken string
+	for _, schema := range schemas {
+		version := schema.GetSchemaDetails().GetVersion()
+		if !versionRange.Matches(version) || (pageStart != "" && semver.Compare(version, pageStart) != 1) ||
+			(len(states) > 0 && !states[schema.GetSchemaData().GetState()]) {
+			continue
+		}
+		if in.GetPageSize() > 0 && len(schemaVersions) == int(in.GetPageSize()) {
+			nextPageToken = encodePageToken(schemaVersions[len(schemaVersions)-1].GetSchemaDetails().GetVersion())
+			break
+		}
+		if in.GetDetailsOnly() {
+			schema.SchemaData.Schema = ""
+		} else if !deleted[schema] {
+			schemaData, err := s.repo.GetConfigSchema(ctx, key+"/"+version)
+			if err != nil {
+				return nil, repositoryError(err, "Error while retrieving schema!")
+			} else if schemaData == nil {
+				continue
+			}
+			schema.SchemaData = schemaData
+		}
+		schemaVersions = append(schemaVersions, schema)
 	}
 	var message string
 	if schemaVersions == nil {
@@ -252,5 +443,106 @@ func (s *Server) GetConfigSchemaVersions(ctx context.Context, in *pb.ConfigSchem
 		Status:         0,
 		Message:        message,
 		SchemaVersions: schemaVersions,
+		NextPageToken:  nextPageToken,
+	}, nil
+}
+
+// Page tokens carry the last version returned on the previous page, so paging
+// stays stable when versions are saved or deleted in between requests.
+func encodePageToken(version string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(version))
+}
+
+func decodePageToken(pageToken string) (string, error) {
+	if pageToken == "" {
+		return "", nil
+	}
+	version, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil || !semver.IsValid(string(version)) {
+		return "", &validators.FieldError{
+			Field:   "page_token",
+			Message: "Page token is invalid!",
+		}
+	}
+	return string(version), nil
+}
+
+func (s *Server) ListNamespaces(ctx context.Context, in *pb.ListNamespacesRequest) (*pb.ListNamespacesResponse, error) {
+	_, err := validators.IsListNamespacesRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	schemaDetails, err := s.repo.GetSchemaDetailsByPrefix(ctx, "")
+	if err != nil {
+		return nil, repositoryError(err, "Error while retrieving namespaces!")
+	}
+	var namespaces []*pb.NamespaceSummary
+	for i, details := range schemaDetails {
+		if i == 0 || details.GetNamespace() != schemaDetails[i-1].GetNamespace() {
+			namespaces = append(namespaces, &pb.NamespaceSummary{Namespace: details.GetNamespace()})
+		}
+		summary := namespaces[len(namespaces)-1]
+		if i == 0 || getConfigSchemaPrefix(details) != getConfigSchemaPrefix(schemaDetails[i-1]) {
+			summary.SchemaCount++
+		}
+		summary.VersionCount++
+	}
+	var message string
+	if namespaces == nil {
+		message = "No namespaces found!"
+	} else {
+		message = "Namespaces retrieved successfully!"
+	}
+	return &pb.ListNamespacesResponse{
+		Status:     0,
+		Message:    message,
+		Namespaces: namespaces,
+	}, nil
+}
+
+func (s *Server) ListSchemas(ctx context.Context, in *pb.ListSchemasRequest) (*pb.ListSchemasResponse, error) {
+	_, err := validators.IsListSchemasRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	schemaDetails, err := s.repo.GetSchemaDetailsByPrefix(ctx, in.GetNamespace()+"/")
+	if err != nil {
+		return nil, repositoryError(err, "Error while retrieving schemas!")
+	}
+	var schemas []*pb.SchemaSummary
+	for i, details := range schemaDetails {
+		if i == 0 || details.GetSchemaName() != schemaDetails[i-1].GetSchemaName() {
+			schemas = append(schemas, &pb.SchemaSummary{
+				Namespace:  details.GetNamespace(),
+				SchemaName: details.GetSchemaName(),
+			})
+		}
+		summary := schemas[len(schemas)-1]
+		summary.VersionCount++
+		summary.LatestVersion = details.GetVersion()
+	}
+	for _, summary := range schemas {
+		key := getConfigSchemaKey(&pb.ConfigSchemaDetails{
+			Namespace:  summary.GetNamespace(),
+			SchemaName: summary.GetSchemaName(),
+			Version:    summary.GetLatestVersion(),
+		})
+		schemaData, err := s.repo.GetConfigSchemaMetadata(ctx, key)
+		if err != nil {
+			return nil, repositoryError(err, "Error while retrieving schemas!")
+		} else if schemaData != nil {
+			summary.LastModified = schemaData.GetCreationTime()
+		}
+	}
+	var message string
+	if schemas == nil {
+		message = "No schemas in namespace '" + in.GetNamespace() + "' found!"
+	} else {
+		message = "Schemas retrieved successfully!"
+	}
+	return &pb.ListSchemasResponse{
+		Status:  0,
+		Message: message,
+		Schemas: schemas,
 	}, nil
 }
@@ -0,0 +1,659 @@
+package configschema
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testUser = &pb.User{Username: "alice", Email: "alice@example.com"}
+
+const (
+	hostSchema     = "type: object\nproperties:\n  host:\n    type: string\n"
+	hostPortSchema = hostSchema + "  port:\n    type: integer\n"
+	hostModeSchema = hostPortSchema + "  mode:\n    type: string\n"
+	portSchema     = "type: object\nproperties:\n  port:\n    type: integer\n"
+)
+
+func testDetails(namespace, schemaName, version string) *pb.ConfigSchemaDetails {
+	return &pb.ConfigSchemaDetails{Namespace: namespace, SchemaName: schemaName, Version: version}
+}
+
+func saveTestSchema(t *testing.T, s *Server, details *pb.ConfigSchemaDetails, schema string, draft bool) {
+	t.Helper()
+	resp, err := s.SaveConfigSchema(context.Background(), &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: details, Schema: schema, Draft: draft})
+	if err != nil || resp.GetStatus() != 0 {
+		t.Fatalf("Saving %v: got %v, %v", details, resp, err)
+	}
+}
+
+// newVersionedServer returns a server storing team/db in versions v1.0.0,
+// v1.1.0, v1.2.0 as a draft and v2.0.0-rc.1.
+func newVersionedServer(t *testing.T) *Server {
+	t.Helper()
+	s := NewServer(repository.NewMemoryRepository())
+	saveTestSchema(t, s, testDetails("team", "db", "v1.0.0"), hostSchema, false)
+	saveTestSchema(t, s, testDetails("team", "db", "v1.1.0"), hostPortSchema, false)
+	saveTestSchema(t, s, testDetails("team", "db", "v1.2.0"), hostModeSchema, true)
+	saveTestSchema(t, s, testDetails("team", "db", "v2.0.0-rc.1"), hostModeSchema, false)
+	return s
+}
+
+func getVersions(t *testing.T, s *Server, in *pb.ConfigSchemaVersionsRequest) ([]string, *pb.ConfigSchemaVersionsResponse) {
+	t.Helper()
+	in.User = testUser
+	in.SchemaDetails = testDetails("team", "db", "")
+	resp, err := s.GetConfigSchemaVersions(context.Background(), in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, schema := range resp.GetSchemaVersions() {
+		got = append(got, schema.GetSchemaDetails().GetVersion())
+	}
+	return got, resp
+}
+
+func TestServerUsesRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	s := NewServer(repo)
+	for _, details := range []*pb.ConfigSchemaDetails{testDetails("team", "db", "v1.0.0"), testDetails("team", "db", "v1.1.0")} {
+		resp, err := s.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: details, Schema: hostPortSchema})
+		if err != nil || resp.GetStatus() != 0 {
+			t.Fatalf("Saving %v: got %v, %v", details, resp, err)
+		}
+	}
+	if stored, err := repo.GetConfigSchema(ctx, "team/db/v1.1.0"); stored == nil || err != nil {
+		t.Fatalf("Got %v, %v, want team/db/v1.1.0 to be stored", stored, err)
+	}
+	resp, err := s.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("team", "db", "v1.0.1"), Schema: hostSchema})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Got %v, %v for a version preceding the latest one, want FailedPrecondition", resp, err)
+	}
+
+	get, err := s.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("team", "db", "v1.0.0")})
+	if err != nil || get.GetSchemaData().GetSchema() == "" {
+		t.Errorf("Got %v, %v, want the stored schema", get, err)
+	}
+	validate, err := s.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("team", "db", "v1.1.0"),
+		Configuration: "host: db.local\nport: db",
+	})
+	if err != nil || validate.GetStatus() != 0 || validate.GetIsValid() {
+		t.Errorf("Got %v, %v, want an invalid configuration", validate, err)
+	}
+	versions, err := s.GetConfigSchemaVersions(ctx, &pb.ConfigSchemaVersionsRequest{User: testUser, SchemaDetails: testDetails("team", "db", "")})
+	if err != nil || len(versions.GetSchemaVersions()) != 2 {
+		t.Errorf("Got %v, %v, want two versions", versions, err)
+	}
+
+	del, err := s.DeleteConfigSchema(ctx, &pb.DeleteConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("team", "db", "v1.0.0")})
+	if err != nil || del.GetStatus() != 0 {
+		t.Fatalf("Got %v, %v deleting a stored schema", del, err)
+	}
+	if stored, err := repo.GetConfigSchema(ctx, "team/db/v1.0.0"); stored != nil || err != nil {
+		t.Errorf("Got %v, %v, want the deleted schema to be gone", stored, err)
+	}
+	_, err = s.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("team", "db", "v1.0.0")})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Got %v getting a deleted schema, want NotFound", err)
+	}
+}
+
+func TestListNamespacesAndSchemas(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository())
+	if resp, err := s.ListNamespaces(ctx, &pb.ListNamespacesRequest{User: testUser}); err != nil || len(resp.GetNamespaces()) != 0 {
+		t.Errorf("Got %v, %v, want no namespaces", resp, err)
+	}
+	saveTestSchema(t, s, testDetails("team", "db", "v1.0.0"), hostSchema, false)
+	saveTestSchema(t, s, testDetails("team", "db", "v1.1.0"), hostPortSchema, false)
+	saveTestSchema(t, s, testDetails("team", "cache", "v0.1.0"), portSchema, false)
+	saveTestSchema(t, s, testDetails("other", "db", "v1.0.0"), hostSchema, false)
+
+	namespaces, err := s.ListNamespaces(ctx, &pb.ListNamespacesRequest{User: testUser})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantNamespaces := []*pb.NamespaceSummary{
+		{Namespace: "other", SchemaCount: 1, VersionCount: 1},
+		{Namespace: "team", SchemaCount: 2, VersionCount: 3},
+	}
+	if len(namespaces.GetNamespaces()) != len(wantNamespaces) {
+		t.Fatalf("Got namespaces %v, want %v", namespaces.GetNamespaces(), wantNamespaces)
+	}
+	for i, got := range namespaces.GetNamespaces() {
+		want := wantNamespaces[i]
+		if got.GetNamespace() != want.GetNamespace() || got.GetSchemaCount() != want.GetSchemaCount() || got.GetVersionCount() != want.GetVersionCount() {
+			t.Errorf("Got namespace %v, want %v", got, want)
+		}
+	}
+
+	schemas, err := s.ListSchemas(ctx, &pb.ListSchemasRequest{User: testUser, Namespace: "team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSchemas := []*pb.SchemaSummary{
+		{Namespace: "team", SchemaName: "cache", VersionCount: 1, LatestVersion: "v0.1.0"},
+		{Namespace: "team", SchemaName: "db", VersionCount: 2, LatestVersion: "v1.1.0"},
+	}
+	if len(schemas.GetSchemas()) != len(wantSchemas) {
+		t.Fatalf("Got schemas %v, want %v", schemas.GetSchemas(), wantSchemas)
+	}
+	for i, got := range schemas.GetSchemas() {
+		want := wantSchemas[i]
+		if got.GetSchemaName() != want.GetSchemaName() || got.GetVersionCount() != want.GetVersionCount() ||
+			got.GetLatestVersion() != want.GetLatestVersion() || got.GetLastModified() == nil {
+			t.Errorf("Got schema %v, want %v", got, want)
+		}
+	}
+	if _, err := s.ListSchemas(ctx, &pb.ListSchemasRequest{User: testUser, Namespace: "team/db"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Got %v for a namespace containing '/', want InvalidArgument", err)
+	}
+}
+
+// metadataOnlyRepository fails every read of a schema body.
+type metadataOnlyRepository struct {
+	repository.SchemaRepository
+}
+
+func (r metadataOnlyRepository) GetConfigSchema(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	return nil, errors.New("schema bodies must not be read")
+}
+
+func TestListSchemasReadsMetadataOnly(t *testing.T) {
+	repo := repository.NewMemoryRepository()
+	saveTestSchema(t, NewServer(repo), testDetails("team", "db", "v1.0.0"), hostSchema, false)
+	s := NewServer(metadataOnlyRepository{repo})
+	resp, err := s.ListSchemas(context.Background(), &pb.ListSchemasRequest{User: testUser, Namespace: "team"})
+	if err != nil || len(resp.GetSchemas()) != 1 || resp.GetSchemas()[0].GetLastModified() == nil {
+		t.Errorf("Got %v, %v, want team/db listed from its metadata", resp, err)
+	}
+}
+
+// countingRepository counts the reads of schema bodies.
+type countingRepository struct {
+	repository.SchemaRepository
+	reads int
+}
+
+func (r *countingRepository) GetConfigSchema(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	r.reads++
+	return r.SchemaRepository.GetConfigSchema(ctx, key)
+}
+
+func TestGetConfigSchemaVersionsReadsPageOnly(t *testing.T) {
+	repo := &countingRepository{SchemaRepository: newVersionedServer(t).repo}
+	s := NewServer(repo)
+	if got, resp := getVersions(t, s, &pb.ConfigSchemaVersionsRequest{PageSize: 2}); len(got) != 2 || repo.reads != 2 || resp.GetSchemaVersions()[0].GetSchemaData().GetSchema() == "" {
+		t.Errorf("Got versions %v after %d reads, want 2 schemas read", got, repo.reads)
+	}
+	s = NewServer(metadataOnlyRepository{repo})
+	if got, _ := getVersions(t, s, &pb.ConfigSchemaVersionsRequest{DetailsOnly: true}); len(got) != 4 {
+		t.Errorf("Got versions %v, want every version listed from its metadata", got)
+	}
+}
+
+func TestGetConfigSchemaVersions(t *testing.T) {
+	ctx := context.Background()
+	s := newVersionedServer(t)
+
+	tests := []struct {
+		name string
+		in   *pb.ConfigSchemaVersionsRequest
+		want []string
+	}{
+		{"all", &pb.ConfigSchemaVersionsRequest{}, []string{"v1.0.0", "v1.1.0", "v1.2.0", "v2.0.0-rc.1"}},
+		{"range", &pb.ConfigSchemaVersionsRequest{VersionRange: ">v1.0.0 <=v1.2.0"}, []string{"v1.1.0", "v1.2.0"}},
+		{"no match", &pb.ConfigSchemaVersionsRequest{VersionRange: ">=v3.0.0"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := getVersions(t, s, tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Got versions %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("paging", func(t *testing.T) {
+		got, resp := getVersions(t, s, &pb.ConfigSchemaVersionsRequest{PageSize: 3})
+		if !reflect.DeepEqual(got, []string{"v1.0.0", "v1.1.0", "v1.2.0"}) || resp.GetNextPageToken() == "" {
+			t.Fatalf("Got first page %v with token %q", got, resp.GetNextPageToken())
+		}
+		// Versions saved between requests do not shift the next page.
+		saveTestSchema(t, s, testDetails("team", "db", "v2.0.0"), hostModeSchema, false)
+		got, resp = getVersions(t, s, &pb.ConfigSchemaVersionsRequest{PageSize: 3, PageToken: resp.GetNextPageToken()})
+		if !reflect.DeepEqual(got, []string{"v2.0.0-rc.1", "v2.0.0"}) || resp.GetNextPageToken() != "" {
+			t.Fatalf("Got second page %v with token %q", got, resp.GetNextPageToken())
+		}
+	})
+
+	t.Run("details only", func(t *testing.T) {
+		_, resp := getVersions(t, s, &pb.ConfigSchemaVersionsRequest{DetailsOnly: true, PageSize: 1})
+		schemaData := resp.GetSchemaVersions()[0].GetSchemaData()
+		if schemaData.GetSchema() != "" || schemaData.GetCreationTime() == nil || schemaData.GetUser().GetUsername() != testUser.GetUsername() {
+			t.Errorf("Got schema data %v, want metadata only", schemaData)
+		}
+	})
+
+	for _, in := range []*pb.ConfigSchemaVersionsRequest{
+		{PageToken: "not a token"},
+		{PageSize: -1},
+		{VersionRange: ">=1.0.0"},
+	} {
+		in.User = testUser
+		in.SchemaDetails = testDetails("team", "db", "")
+		if _, err := s.GetConfigSchemaVersions(ctx, in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Got %v for %v, want InvalidArgument", err, in)
+		}
+	}
+}
+
+func TestGetConfigSchemaSelectors(t *testing.T) {
+	ctx := context.Background()
+	s := newVersionedServer(t)
+	get := func(version string) (*pb.GetConfigSchemaResponse, error) {
+		return s.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("team", "db", version)})
+	}
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"latest", "v2.0.0-rc.1"},
+		{"latest-stable", "v1.1.0"},
+		{"~v1.0.0", "v1.0.0"},
+		{"^v1.0.0", "v1.1.0"},
+		{"v1.2.0", "v1.2.0"},
+	}
+	for _, tt := range tests {
+		resp, err := get(tt.version)
+		if err != nil || resp.GetResolvedVersion() != tt.want {
+			t.Errorf("%q resolved to %v, %v, want %s", tt.version, resp.GetResolvedVersion(), err, tt.want)
+		}
+	}
+	if _, err := get(">=v3.0.0"); status.Code(err) != codes.NotFound {
+		t.Errorf("Got %v for an unmatched range, want NotFound", err)
+	}
+	if _, err := get("newest"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Got %v for an invalid selector, want InvalidArgument", err)
+	}
+
+	validate, err := s.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("team", "db", "latest-stable"),
+		Configuration: "host: db.local\nport: 5432\n",
+	})
+	if err != nil || !validate.GetIsValid() || validate.GetResolvedVersion() != "v1.1.0" {
+		t.Errorf("Got %v, %v, want a valid configuration against v1.1.0", validate, err)
+	}
+
+	if _, err := s.SetLifecycleState(ctx, &pb.SetLifecycleStateRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("team", "db", "v1.1.0"),
+		State:         pb.LifecycleState_RETIRED,
+		Reason:        "Port is configured elsewhere",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := get("latest-stable"); err != nil || resp.GetResolvedVersion() != "v1.0.0" {
+		t.Errorf("latest-stable resolved to %v, %v, want v1.0.0 once v1.1.0 is retired", resp.GetResolvedVersion(), err)
+	}
+	resp, err := get("v1.1.0")
+	if err != nil || resp.GetSchemaData().GetState() != pb.LifecycleState_RETIRED || resp.GetSchemaData().GetStateReason() != "Port is configured elsewhere" {
+		t.Errorf("Got %v, %v, want the retired schema", resp.GetSchemaData(), err)
+	}
+}
+
+func TestLifecycleStates(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository())
+	details := testDetails("team", "db", "v1.0.0")
+	validate := func() (*pb.ValidateConfigurationResponse, error) {
+		return s.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{
+			User:          testUser,
+			SchemaDetails: details,
+			Configuration: "port: 5432\n",
+		})
+	}
+	setState := func(state pb.LifecycleState, reason string) error {
+		_, err := s.SetLifecycleState(ctx, &pb.SetLifecycleStateRequest{User: testUser, SchemaDetails: details, State: state, Reason: reason})
+		return err
+	}
+
+	// Drafts can be overwritten, and validating against them warns.
+	saveTestSchema(t, s, details, "type: object\nproperties:\n  port:\n    type: string\n", true)
+	if resp, err := validate(); err != nil || resp.GetIsValid() {
+		t.Fatalf("Got %v, %v, want port to be rejected by the first draft", resp, err)
+	}
+	saveTestSchema(t, s, details, portSchema, true)
+	resp, err := validate()
+	if err != nil || !resp.GetIsValid() || len(resp.GetWarnings()) != 1 {
+		t.Fatalf("Got %v, %v, want a warning about the overwritten draft", resp, err)
+	}
+	if err := setState(pb.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED, ""); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Got %v for an unspecified state, want InvalidArgument", err)
+	}
+	if err := setState(pb.LifecycleState_DEPRECATED, ""); status.Code(err) != codes.FailedPrecondition || errorReason(err) != "INVALID_STATE_TRANSITION" {
+		t.Errorf("Got %v deprecating a draft, want INVALID_STATE_TRANSITION", err)
+	}
+
+	if err := setState(pb.LifecycleState_PUBLISHED, ""); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := validate(); err != nil || len(resp.GetWarnings()) != 0 {
+		t.Errorf("Got %v, %v, want no warnings for a published schema", resp, err)
+	}
+	if _, err := s.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: details, Schema: portSchema, Draft: true}); status.Code(err) == codes.OK {
+		t.Error("Overwrote a published schema")
+	}
+	if err := setState(pb.LifecycleState_DEPRECATED, "Use v2."); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := validate(); err != nil || len(resp.GetWarnings()) != 1 || !strings.Contains(resp.GetWarnings()[0], "Use v2.") {
+		t.Errorf("Got %v, %v, want a deprecation warning", resp, err)
+	}
+	if err := setState(pb.LifecycleState_RETIRED, "Gone."); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := validate(); status.Code(err) != codes.FailedPrecondition || errorReason(err) != "SCHEMA_RETIRED" {
+		t.Errorf("Got %v validating against a retired schema, want SCHEMA_RETIRED", err)
+	}
+
+	versions, err := s.GetConfigSchemaVersions(ctx, &pb.ConfigSchemaVersionsRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("team", "db", ""),
+		States:        []pb.LifecycleState{pb.LifecycleState_PUBLISHED},
+	})
+	if err != nil || len(versions.GetSchemaVersions()) != 0 {
+		t.Errorf("Got %v, %v, want no published versions", versions, err)
+	}
+}
+
+func TestRestoreAndPurgeConfigSchema(t *testing.T) {
+	ctx := context.Background()
+	s := newVersionedServer(t)
+	details := testDetails("team", "db", "v1.1.0")
+	deleteSchema := func() {
+		t.Helper()
+		if _, err := s.DeleteConfigSchema(ctx, &pb.DeleteConfigSchemaRequest{User: testUser, SchemaDetails: details}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deleteSchema()
+	if _, err := s.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: details}); status.Code(err) != codes.NotFound {
+		t.Errorf("Got %v for a deleted schema, want NotFound", err)
+	}
+	resp, err := s.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: details, IncludeDeleted: true})
+	if err != nil || resp.GetSchemaData().GetDeletedBy().GetUsername() != "alice" || resp.GetSchemaData().GetDeletionTime() == nil {
+		t.Errorf("Got %v, %v, want the tombstone", resp.GetSchemaData(), err)
+	}
+
+	if _, err := s.RestoreConfigSchema(ctx, &pb.RestoreConfigSchemaRequest{User: testUser, SchemaDetails: details}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = s.GetConfigSchema(ctx, &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: details})
+	if err != nil || resp.GetSchemaData().GetDeletionTime() != nil {
+		t.Errorf("Got %v, %v, want the restored schema", resp.GetSchemaData(), err)
+	}
+
+	deleteSchema()
+	if _, err := s.PurgeConfigSchema(ctx, &pb.PurgeConfigSchemaRequest{User: testUser, SchemaDetails: details}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RestoreConfigSchema(ctx, &pb.RestoreConfigSchemaRequest{User: testUser, SchemaDetails: details}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Got %v restoring a purged schema, want FailedPrecondition", err)
+	}
+	// Purged versions stay reserved.
+	suggestion, err := s.SuggestNextVersion(ctx, &pb.SuggestNextVersionRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("team", "db", ""),
+		Schema:        hostModeSchema,
+	})
+	if err != nil || suggestion.GetSuggestedVersion() != "v2.0.0" {
+		t.Errorf("Got suggestion %v, %v, want v2.0.0", suggestion, err)
+	}
+}
+
+func TestCompatibilityChecksOnSave(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository(), WithDefaultCompatibilityMode(pb.CompatibilityMode_BACKWARD))
+	details := testDetails("team", "db", "v1.0.0")
+	saveTestSchema(t, s, details, hostSchema, false)
+	getMode := func() pb.CompatibilityMode {
+		t.Helper()
+		resp, err := s.GetCompatibilityMode(ctx, &pb.GetCompatibilityModeRequest{User: testUser, SchemaDetails: details})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.GetMode()
+	}
+	setMode := func(mode pb.CompatibilityMode) {
+		t.Helper()
+		if _, err := s.SetCompatibilityMode(ctx, &pb.SetCompatibilityModeRequest{User: testUser, SchemaDetails: details, Mode: mode}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	save := func(version string, schema string) error {
+		_, err := s.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("team", "db", version), Schema: schema})
+		return err
+	}
+
+	if mode := getMode(); mode != pb.CompatibilityMode_BACKWARD {
+		t.Errorf("Got mode %v, want the server default", mode)
+	}
+	if err := save("v2.0.0", portSchema); status.Code(err) != codes.FailedPrecondition || errorReason(err) != "SCHEMA_INCOMPATIBLE" {
+		t.Errorf("Got %v for a breaking change, want SCHEMA_INCOMPATIBLE", err)
+	}
+	if err := save("v1.1.0", hostPortSchema); err != nil {
+		t.Errorf("Got %v for a backward compatible change", err)
+	}
+
+	setMode(pb.CompatibilityMode_FORWARD)
+	if mode := getMode(); mode != pb.CompatibilityMode_FORWARD {
+		t.Errorf("Got mode %v, want FORWARD", mode)
+	}
+	if err := save("v1.2.0", hostModeSchema); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Got %v for a forward incompatible change, want FailedPrecondition", err)
+	}
+
+	setMode(pb.CompatibilityMode_NONE)
+	if err := save("v1.1.1", portSchema); status.Code(err) != codes.FailedPrecondition || errorReason(err) != "VERSION_BUMP_REQUIRED" {
+		t.Errorf("Got %v for a breaking patch, want VERSION_BUMP_REQUIRED", err)
+	}
+	if err := save("v2.0.0", portSchema); err != nil {
+		t.Errorf("Got %v for a major bump without compatibility checks", err)
+	}
+}
+
+func TestSuggestNextVersion(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository())
+	suggest := func(namespace string, schema string) *pb.SuggestNextVersionResponse {
+		t.Helper()
+		resp, err := s.SuggestNextVersion(ctx, &pb.SuggestNextVersionRequest{
+			User:          testUser,
+			SchemaDetails: testDetails(namespace, "db", ""),
+			Schema:        schema,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	if resp := suggest("team", hostSchema); resp.GetSuggestedVersion() != "v1.0.0" || resp.GetLatestVersion() != "" {
+		t.Errorf("Got %v, want v1.0.0 for a new schema", resp)
+	}
+	saveTestSchema(t, s, testDetails("team", "db", "v1.0.0"), hostSchema, false)
+	saveTestSchema(t, s, testDetails("experimental", "db", "v0.3.0"), hostSchema, false)
+
+	tests := []struct {
+		namespace string
+		schema    string
+		version   string
+		bump      pb.VersionBump
+	}{
+		{"team", hostSchema, "v1.0.1", pb.VersionBump_PATCH},
+		{"team", hostPortSchema, "v1.1.0", pb.VersionBump_MINOR},
+		{"team", portSchema, "v2.0.0", pb.VersionBump_MAJOR},
+		{"experimental", hostPortSchema, "v0.3.1", pb.VersionBump_PATCH},
+		{"experimental", portSchema, "v0.4.0", pb.VersionBump_MINOR},
+	}
+	for _, tt := range tests {
+		resp := suggest(tt.namespace, tt.schema)
+		if resp.GetSuggestedVersion() != tt.version || resp.GetRequiredBump() != tt.bump {
+			t.Errorf("Got %s, %v for %s, want %s, %v", resp.GetSuggestedVersion(), resp.GetRequiredBump(), tt.namespace, tt.version, tt.bump)
+		}
+	}
+}
+
+func TestDiffConfigSchemas(t *testing.T) {
+	ctx := context.Background()
+	s := newVersionedServer(t)
+	resp, err := s.DiffConfigSchemas(ctx, &pb.DiffConfigSchemasRequest{
+		User:          testUser,
+		BaseDetails:   testDetails("team", "db", "v1.0.0"),
+		TargetDetails: testDetails("team", "db", "~v1.1.0"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetBaseVersion() != "v1.0.0" || resp.GetTargetVersion() != "v1.1.0" || resp.GetHasBreakingChanges() ||
+		len(resp.GetChanges()) != 1 || resp.GetChanges()[0].GetPath() != "#/properties/port" {
+		t.Errorf("Got %v, want port to be added", resp)
+	}
+
+	resp, err = s.DiffConfigSchemas(ctx, &pb.DiffConfigSchemasRequest{
+		User:            testUser,
+		BaseDetails:     testDetails("team", "db", "v1.1.0"),
+		CandidateSchema: portSchema,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetTargetVersion() != "" || !resp.GetHasBreakingChanges() || len(resp.GetChanges()) != 1 || !resp.GetChanges()[0].GetBreaking() {
+		t.Errorf("Got %v, want host to be removed", resp)
+	}
+
+	for _, in := range []*pb.DiffConfigSchemasRequest{
+		{User: testUser, BaseDetails: testDetails("team", "db", "v1.0.0")},
+		{User: testUser, BaseDetails: testDetails("team", "db", "v1.0.0"), TargetDetails: testDetails("team", "db", "v1.1.0"), CandidateSchema: portSchema},
+	} {
+		if _, err := s.DiffConfigSchemas(ctx, in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Got %v for %v, want InvalidArgument", err, in)
+		}
+	}
+	if _, err := s.DiffConfigSchemas(ctx, &pb.DiffConfigSchemasRequest{
+		User:          testUser,
+		BaseDetails:   testDetails("team", "db", "v1.0.0"),
+		TargetDetails: testDetails("team", "db", "v3.0.0"),
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("Got %v for a missing target, want NotFound", err)
+	}
+}
+
+func TestSchemaReferences(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository())
+	referencing := "type: object\nproperties:\n  port:\n    $ref: quasar://common/port/v1.0.0#/definitions/port\n"
+	_, err := s.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("team", "db", "v1.0.0"), Schema: referencing})
+	if status.Code(err) != codes.FailedPrecondition || errorReason(err) != "REFERENCE_NOT_FOUND" {
+		t.Fatalf("Got %v for a missing reference, want REFERENCE_NOT_FOUND", err)
+	}
+	saveTestSchema(t, s, testDetails("common", "port", "v1.0.0"), "definitions:\n  port:\n    type: integer\n    maximum: 65535\n", false)
+	saveTestSchema(t, s, testDetails("team", "db", "v1.0.0"), referencing, false)
+
+	validate := func(configuration string) *pb.ValidateConfigurationResponse {
+		t.Helper()
+		resp, err := s.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{
+			User:          testUser,
+			SchemaDetails: testDetails("team", "db", "v1.0.0"),
+			Configuration: configuration,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	if resp := validate("port: 8080"); !resp.GetIsValid() {
+		t.Errorf("Got %v, want a valid configuration", resp)
+	}
+	if resp := validate("port: 70000"); resp.GetIsValid() || resp.GetErrors()[0].GetInstancePath() != "/port" {
+		t.Errorf("Got %v, want port to exceed the referenced maximum", resp)
+	}
+
+	_, err = s.DeleteConfigSchema(ctx, &pb.DeleteConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("common", "port", "v1.0.0")})
+	if status.Code(err) != codes.FailedPrecondition || errorReason(err) != "SCHEMA_REFERENCED" {
+		t.Errorf("Got %v deleting a referenced schema, want SCHEMA_REFERENCED", err)
+	}
+	_, err = s.SaveConfigSchema(ctx, &pb.SaveConfigSchemaRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("team", "cache", "v1.0.0"),
+		Schema:        "properties:\n  port:\n    $ref: quasar://common/port/latest#/definitions/port\n",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Got %v for a reference to a version selector, want InvalidArgument", err)
+	}
+}
+
+func TestRoleBindings(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(repository.NewMemoryRepository())
+	for _, binding := range []*pb.RoleBinding{
+		{Username: "alice", Namespace: "payments", Role: pb.Role_PUBLISHER},
+		{Username: "bob", Namespace: "*", Role: pb.Role_READER},
+		{Username: "carol", Namespace: "billing", Role: pb.Role_ADMIN},
+	} {
+		if _, err := s.SetRoleBinding(ctx, &pb.SetRoleBindingRequest{User: testUser, Binding: binding}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	listUsers := func(namespace string) []string {
+		t.Helper()
+		resp, err := s.ListRoleBindings(ctx, &pb.ListRoleBindingsRequest{User: testUser, Namespace: namespace})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var users []string
+		for _, binding := range resp.GetBindings() {
+			users = append(users, binding.GetUsername())
+		}
+		return users
+	}
+	if got := listUsers(""); len(got) != 3 {
+		t.Errorf("Got bindings of %v, want all three", got)
+	}
+	if got := listUsers("payments"); !reflect.DeepEqual(got, []string{"bob", "alice"}) {
+		t.Errorf("Got bindings of %v in payments, want [bob alice]", got)
+	}
+
+	// Saving the unspecified role removes the binding.
+	if _, err := s.SetRoleBinding(ctx, &pb.SetRoleBindingRequest{User: testUser, Binding: &pb.RoleBinding{Username: "alice", Namespace: "payments"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := listUsers("payments"); !reflect.DeepEqual(got, []string{"bob"}) {
+		t.Errorf("Got bindings of %v in payments after removal, want [bob]", got)
+	}
+
+	invalid := []*pb.RoleBinding{
+		nil,
+		{Namespace: "payments", Role: pb.Role_READER},
+		{Username: "alice", Role: pb.Role_READER},
+		{Username: "alice", Namespace: "pay/ments", Role: pb.Role_READER},
+		{Username: "alice", Namespace: "payments", Role: pb.Role(42)},
+	}
+	for _, binding := range invalid {
+		if _, err := s.SetRoleBinding(ctx, &pb.SetRoleBindingRequest{User: testUser, Binding: binding}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Got %v saving binding %v, want InvalidArgument", err, binding)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package configschema
+
+import (
+	"context"
+
+	"github.com/jtomic1/config-schema-service/internal/schemadiff"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+func (s *Server) DiffConfigSchemas(ctx context.Context, in *pb.DiffConfigSchemasRequest) (*pb.DiffConfigSchemasResponse, error) {
+	_, err := validators.IsDiffConfigSchemasRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	baseDetails, baseData, err := s.getResolvedSchema(ctx, in.GetBaseDetails())
+	if err != nil {
+		return nil, repositoryError(err, "Error while retrieving schema!")
+	}
+	targetSchema, targetVersion := in.GetCandidateSchema(), ""
+	if in.GetTargetDetails() != nil {
+		targetDetails, targetData, err := s.getResolvedSchema(ctx, in.GetTargetDetails())
+		if err != nil {
+			return nil, repositoryError(err, "Error while retrieving schema!")
+		}
+		targetSchema, targetVersion = targetData.GetSchema(), targetDetails.GetVersion()
+	}
+	changes, err := schemadiff.Compare(baseData.GetSchema(), targetSchema)
+	if err != nil {
+		return nil, repositoryError(err, "Error while comparing schemas!")
+	}
+	schemaChanges := make([]*pb.SchemaChange, len(changes))
+	hasBreakingChanges := false
+	for i, change := range changes {
+		schemaChanges[i] = &pb.SchemaChange{
+			Path:        change.Path,
+			Kind:        change.Kind,
+			Description: change.Description,
+			Breaking:    change.Narrowing,
+		}
+		hasBreakingChanges = hasBreakingChanges || change.Narrowing
+	}
+	var message string
+	if len(changes) == 0 {
+		message = "The schemas are structurally equal!"
+	} else {
+		message = "Schemas compared successfully!"
+	}
+	return &pb.DiffConfigSchemasResponse{
+		Status:             0,
+		Message:            message,
+		Changes:            schemaChanges,
+		HasBreakingChanges: hasBreakingChanges,
+		BaseVersion:        baseDetails.GetVersion(),
+		TargetVersion:      targetVersion,
+	}, nil
+}
@@ -0,0 +1,236 @@
+package configschema
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/references"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const errorDomain = "config-schema-service"
+
+func newStatusError(code codes.Code, message string, details ...proto.Message) error {
+	st := &spb.Status{
+		Code:    int32(code),
+		Message: message,
+	}
+	for _, detail := range details {
+		if packed, err := anypb.New(detail); err == nil {
+			st.Details = append(st.Details, packed)
+		}
+	}
+	return status.FromProto(st).Err()
+}
+
+func invalidArgumentError(err error) error {
+	var fieldErr *validators.FieldError
+	if !errors.As(err, &fieldErr) {
+		return newStatusError(codes.InvalidArgument, err.Error())
+	}
+	return newStatusError(codes.InvalidArgument, err.Error(),
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       fieldErr.Field,
+				Description: fieldErr.Message,
+			}},
+		},
+		&errdetails.ErrorInfo{
+			Reason:   "INVALID_FIELD",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"field": fieldErr.Field},
+		},
+	)
+}
+
+// repositoryError maps errors returned by the repository onto gRPC statuses.
+// Errors the repository does not classify are reported as Internal using
+// internalMessage, so backend details are not leaked to clients.
+func repositoryError(err error, internalMessage string) error {
+	var existsErr *repository.SchemaExistsError
+	var notFoundErr *repository.SchemaNotFoundError
+	var notLatestErr *repository.VersionNotLatestError
+	var referenceNotFoundErr *repository.ReferenceNotFoundError
+	var draftReferenceErr *repository.DraftReferenceError
+	var selfReferenceErr *repository.SelfReferenceError
+	var referencedErr *repository.SchemaReferencedError
+	var compactedErr *repository.RevisionCompactedError
+	var tombstoneNotFoundErr *repository.TombstoneNotFoundError
+	var purgedErr *repository.SchemaPurgedError
+	var retentionErr *repository.RetentionExpiredError
+	var transitionErr *repository.InvalidTransitionError
+	switch {
+	case errors.As(err, &existsErr):
+		return newStatusError(codes.AlreadyExists, err.Error(), &errdetails.ErrorInfo{
+			Reason:   "SCHEMA_ALREADY_EXISTS",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"key": existsErr.Key},
+		})
+	case errors.As(err, &notFoundErr):
+		return newStatusError(codes.NotFound, err.Error(), &errdetails.ErrorInfo{
+			Reason:   "SCHEMA_NOT_FOUND",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"key": notFoundErr.Key},
+		})
+	case errors.As(err, &notLatestErr):
+		return newStatusError(codes.FailedPrecondition, err.Error(), &errdetails.ErrorInfo{
+			Reason:   "VERSION_NOT_LATEST",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"latest_version": notLatestErr.LatestVersion},
+		})
+	case errors.As(err, &referenceNotFoundErr):
+		return newStatusError(codes.FailedPrecondition, err.Error(), &errdetails.ErrorInfo{
+			Reason:   "REFERENCE_NOT_FOUND",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"reference": references.URI(referenceNotFoundErr.Key)},
+		})
+	case errors.As(err, &draftReferenceErr):
+		return newStatusError(codes.FailedPrecondition, err.Error(), &errdetails.ErrorInfo{
+			Reason:   "REFERENCE_IS_DRAFT",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"reference": references.URI(draftReferenceErr.Key)},
+		})
+	case errors.As(err, &selfReferenceErr):
+		return invalidArgumentError(&validators.FieldError{
+			Field:   "schema",
+			Message: err.Error(),
+		})
+	case errors.As(err, &referencedErr):
+		return newStatusError(codes.FailedPrecondition, err.Error(), &errdetails.ErrorInfo{
+			Reason: "SCHEMA_REFERENCED",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"key":           referencedErr.Key,
+				"referenced_by": strings.Join(referencedErr.ReferencedBy, ","),
+			},
+		})
+	case errors.As(err, &compactedErr):
+		return newStatusError(codes.OutOfRange, err.Error(), &errdetails.ErrorInfo{
+			Reason:   "REVISION_COMPACTED",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"compact_revision": strconv.FormatInt(compactedErr.CompactRevision, 10)},
+		})
+	case errors.As(err, &tombstoneNotFoundErr):
+		return newStatusError(codes.NotFound, err.Error(), &errdetails.ErrorInfo{
+			Reason:   "DELETED_SCHEMA_NOT_FOUND",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"key": tombstoneNotFoundErr.Key},
+		})
+	case errors.As(err, &purgedErr):
+		return newStatusError(codes.FailedPrecondition, err.Error(), &errdetails.ErrorInfo{
+			Reason:   "SCHEMA_PURGED",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"key": purgedErr.Key},
+		})
+	case errors.As(err, &retentionErr):
+		return newStatusError(codes.FailedPrecondition, err.Error(), &errdetails.ErrorInfo{
+			Reason: "RETENTION_EXPIRED",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"key":           retentionErr.Key,
+				"deletion_time": retentionErr.DeletionTime.UTC().Format(time.RFC3339),
+			},
+		})
+	case errors.As(err, &transitionErr):
+		return newStatusError(codes.FailedPrecondition, err.Error(), &errdetails.ErrorInfo{
+			Reason: "INVALID_STATE_TRANSITION",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"key":  transitionErr.Key,
+				"from": transitionErr.From.String(),
+				"to":   transitionErr.To.String(),
+			},
+		})
+	case errors.Is(err, repository.ErrWatchClosed):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Internal, internalMessage)
+	}
+}
+
+// legacyCodes maps the codes returned by the RPCs of the original service onto
+// the status they reported for the same failure. Codes a method does not list,
+// and the RPCs added later, are reported unchanged.
+var legacyCodes = map[string]map[codes.Code]codes.Code{
+	"SaveConfigSchema": {
+		codes.AlreadyExists:      codes.Internal,
+		codes.FailedPrecondition: codes.InvalidArgument,
+	},
+	"GetConfigSchema": {
+		codes.NotFound: codes.OK,
+	},
+	"DeleteConfigSchema": {
+		codes.NotFound:           codes.InvalidArgument,
+		codes.FailedPrecondition: codes.InvalidArgument,
+		codes.Internal:           codes.InvalidArgument,
+	},
+	"ValidateConfiguration": {
+		codes.NotFound:           codes.InvalidArgument,
+		codes.FailedPrecondition: codes.InvalidArgument,
+	},
+}
+
+// LegacyStatusInterceptor restores the original error reporting of the service,
+// where every call succeeds and failures are described by the status and
+// message fields of the response, using the codes listed in legacyCodes.
+func LegacyStatusInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		st, ok := status.FromError(err)
+		if !ok {
+			return resp, err
+		}
+		legacyResp, legacyErr := newLegacyResponse(info.FullMethod, st)
+		if legacyErr != nil {
+			return resp, err
+		}
+		return legacyResp, nil
+	}
+}
+
+func newLegacyResponse(fullMethod string, st *status.Status) (protoreflect.ProtoMessage, error) {
+	methodName := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	service := pb.File_config_schema_proto.Services().ByName("ConfigSchemaService")
+	method := service.Methods().ByName(protoreflect.Name(methodName))
+	if method == nil {
+		return nil, errors.New("unknown method '" + fullMethod + "'")
+	}
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	resp := messageType.New()
+	fields := resp.Descriptor().Fields()
+	statusField, messageField := fields.ByName("status"), fields.ByName("message")
+	if statusField == nil || messageField == nil {
+		return nil, errors.New("response of '" + fullMethod + "' has no legacy status fields")
+	}
+	code := st.Code()
+	if legacyCode, ok := legacyCodes[methodName][code]; ok {
+		code = legacyCode
+	}
+	resp.Set(statusField, protoreflect.ValueOfInt32(int32(code)))
+	resp.Set(messageField, protoreflect.ValueOfString(st.Message()))
+	return resp.Interface(), nil
+}
@@ -0,0 +1,142 @@
+package configschema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type legacyResponse interface {
+	GetStatus() int32
+	GetMessage() string
+}
+
+func fullMethod(method string) string {
+	return "/" + pb.ConfigSchemaService_ServiceDesc.ServiceName + "/" + method
+}
+
+func callLegacy(t *testing.T, method string, req interface{}, handler grpc.UnaryHandler) legacyResponse {
+	t.Helper()
+	info := &grpc.UnaryServerInfo{FullMethod: fullMethod(method)}
+	resp, err := LegacyStatusInterceptor()(context.Background(), req, info, handler)
+	if err != nil {
+		t.Fatalf("%s returned error in legacy mode: %v", method, err)
+	}
+	return resp.(legacyResponse)
+}
+
+func errorReason(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func TestRepositoryError(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{&repository.SchemaExistsError{Key: "team/db/v1.0.0"}, codes.AlreadyExists, "SCHEMA_ALREADY_EXISTS"},
+		{&repository.SchemaNotFoundError{Key: "team/db/v1.0.0"}, codes.NotFound, "SCHEMA_NOT_FOUND"},
+		{&repository.VersionNotLatestError{LatestVersion: "v1.0.0"}, codes.FailedPrecondition, "VERSION_NOT_LATEST"},
+		{&repository.DraftReferenceError{Key: "team/db/v1.0.0"}, codes.FailedPrecondition, "REFERENCE_IS_DRAFT"},
+		{&repository.SelfReferenceError{Key: "team/db/v1.0.0"}, codes.InvalidArgument, "INVALID_FIELD"},
+		{context.Canceled, codes.Canceled, ""},
+		{context.DeadlineExceeded, codes.DeadlineExceeded, ""},
+		{errors.New("connection refused"), codes.Internal, ""},
+	}
+	for _, tt := range tests {
+		err := repositoryError(tt.err, "Storage failed!")
+		if status.Code(err) != tt.code || errorReason(err) != tt.reason {
+			t.Errorf("%v: got %v with reason %q, want %v with reason %q", tt.err, status.Code(err), errorReason(err), tt.code, tt.reason)
+		}
+	}
+	if msg := status.Convert(repositoryError(errors.New("connection refused"), "Storage failed!")).Message(); msg != "Storage failed!" {
+		t.Errorf("Internal error message = %q, want the backend error to be hidden", msg)
+	}
+}
+
+func TestInvalidArgumentNamesField(t *testing.T) {
+	s := NewServer(repository.NewMemoryRepository())
+	_, err := s.SaveConfigSchema(context.Background(), &pb.SaveConfigSchemaRequest{
+		User:          &pb.User{Username: "alice"},
+		SchemaDetails: testDetails("team", "db", "v1.0.0"),
+		Schema:        hostSchema,
+	})
+	if status.Code(err) != codes.InvalidArgument || errorReason(err) != "INVALID_FIELD" {
+		t.Fatalf("Got %v, want InvalidArgument with reason INVALID_FIELD", err)
+	}
+	var field string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			field = badRequest.GetFieldViolations()[0].GetField()
+		}
+	}
+	if field != "user.email" {
+		t.Errorf("Got field violation %q, want user.email", field)
+	}
+}
+
+func TestLegacyStatusInterceptor(t *testing.T) {
+	s := NewServer(repository.NewMemoryRepository())
+	details := testDetails("team", "db", "v1.0.0")
+	missing := testDetails("team", "db", "v9.0.0")
+	saveHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.SaveConfigSchema(ctx, req.(*pb.SaveConfigSchemaRequest))
+	}
+	save := &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: details, Schema: hostSchema}
+	if resp := callLegacy(t, "SaveConfigSchema", save, saveHandler); resp.GetStatus() != 0 {
+		t.Fatalf("Got status %d, message %q for a successful save", resp.GetStatus(), resp.GetMessage())
+	}
+
+	// The procedures of the original service report the codes they reported
+	// before gRPC status codes were introduced.
+	tests := []struct {
+		name    string
+		method  string
+		req     interface{}
+		handler grpc.UnaryHandler
+		status  codes.Code
+	}{
+		{"save existing version", "SaveConfigSchema", save, saveHandler, codes.InvalidArgument},
+		{"save older version", "SaveConfigSchema", &pb.SaveConfigSchemaRequest{User: testUser, SchemaDetails: testDetails("team", "db", "v0.1.0"), Schema: hostSchema}, saveHandler, codes.InvalidArgument},
+		{"get missing schema", "GetConfigSchema", &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: missing}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.GetConfigSchema(ctx, req.(*pb.GetConfigSchemaRequest))
+		}, codes.OK},
+		{"delete missing schema", "DeleteConfigSchema", &pb.DeleteConfigSchemaRequest{User: testUser, SchemaDetails: missing}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.DeleteConfigSchema(ctx, req.(*pb.DeleteConfigSchemaRequest))
+		}, codes.InvalidArgument},
+		{"validate against missing schema", "ValidateConfiguration", &pb.ValidateConfigurationRequest{User: testUser, SchemaDetails: missing, Configuration: "port: 1"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.ValidateConfiguration(ctx, req.(*pb.ValidateConfigurationRequest))
+		}, codes.InvalidArgument},
+		{"restore missing schema", "RestoreConfigSchema", &pb.RestoreConfigSchemaRequest{User: testUser, SchemaDetails: missing}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return s.RestoreConfigSchema(ctx, req.(*pb.RestoreConfigSchemaRequest))
+		}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := callLegacy(t, tt.method, tt.req, tt.handler)
+			if resp.GetStatus() != int32(tt.status) || resp.GetMessage() == "" {
+				t.Errorf("Got status %d, message %q, want status %d", resp.GetStatus(), resp.GetMessage(), tt.status)
+			}
+		})
+	}
+
+	resp := callLegacy(t, "GetConfigSchema", &pb.GetConfigSchemaRequest{User: testUser, SchemaDetails: missing}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.GetConfigSchema(ctx, req.(*pb.GetConfigSchemaRequest))
+	})
+	if resp.GetMessage() != "No schema with key 'team/db/v9.0.0' found!" || resp.(*pb.GetConfigSchemaResponse).GetSchemaData() != nil {
+		t.Errorf("Got message %q and schema data for a missing schema", resp.GetMessage())
+	}
+}
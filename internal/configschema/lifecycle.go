@@ -0,0 +1,72 @@
+package configschema
+
+import (
+	"context"
+
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *Server) SetLifecycleState(ctx context.Context, in *pb.SetLifecycleStateRequest) (*pb.SetLifecycleStateResponse, error) {
+	_, err := validators.IsSetLifecycleStateRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	key := getConfigSchemaKey(in.GetSchemaDetails())
+	if err := s.repo.SetLifecycleState(ctx, key, in.GetState(), in.GetReason()); err != nil {
+		return nil, repositoryError(err, "Error while changing lifecycle state!")
+	}
+	s.cache.remove(key)
+	return &pb.SetLifecycleStateResponse{
+		Status:  0,
+		Message: "Lifecycle state changed successfully!",
+	}, nil
+}
+
+// isSelectable reports whether a version in state can be selected by
+// "latest", "latest-stable" or a version range.
+func isSelectable(state pb.LifecycleState) bool {
+	return state == pb.LifecycleState_PUBLISHED || state == pb.LifecycleState_DEPRECATED
+}
+
+func removeVersion(versions []string, version string) []string {
+	remaining := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if v != version {
+			remaining = append(remaining, v)
+		}
+	}
+	return remaining
+}
+
+// stateWarnings returns the warnings reported when validating against a
+// schema which is deprecated or still a draft.
+func stateWarnings(schema *compiledSchema) []string {
+	var warning string
+	switch schema.state {
+	case pb.LifecycleState_DEPRECATED:
+		warning = "Schema '" + schema.key + "' is deprecated!"
+	case pb.LifecycleState_DRAFT:
+		warning = "Schema '" + schema.key + "' is a draft and may still change!"
+	default:
+		return nil
+	}
+	if schema.stateReason != "" {
+		warning += " " + schema.stateReason
+	}
+	return []string{warning}
+}
+
+func schemaRetiredError(schema *compiledSchema) error {
+	message := "Schema '" + schema.key + "' is retired and can no longer be used for validation!"
+	if schema.stateReason != "" {
+		message += " " + schema.stateReason
+	}
+	return newStatusError(codes.FailedPrecondition, message, &errdetails.ErrorInfo{
+		Reason:   "SCHEMA_RETIRED",
+		Domain:   errorDomain,
+		Metadata: map[string]string{"key": schema.key},
+	})
+}
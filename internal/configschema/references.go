@@ -0,0 +1,62 @@
+package configschema
+
+import (
+	"context"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/references"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/yaml"
+)
+
+// loadReferences returns the JSON of every stored schema which schema
+// references directly or transitively, and the creation time of each, keyed
+// by schema key.
+func (s *Server) loadReferences(ctx context.Context, schema string) (map[string]string, map[string]time.Time, error) {
+	pending, err := references.Find(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	loaded := make(map[string]string)
+	creationTimes := make(map[string]time.Time)
+	for len(pending) > 0 {
+		key := pending[0]
+		pending = pending[1:]
+		if _, ok := loaded[key]; ok {
+			continue
+		}
+		schemaData, err := s.repo.GetConfigSchema(ctx, key)
+		if err != nil {
+			return nil, nil, err
+		} else if schemaData == nil {
+			return nil, nil, &repository.ReferenceNotFoundError{Key: key}
+		}
+		schemaJson, err := yaml.YAMLToJSON([]byte(schemaData.GetSchema()))
+		if err != nil {
+			return nil, nil, err
+		}
+		loaded[key] = string(schemaJson)
+		creationTimes[key] = schemaData.GetCreationTime().AsTime()
+		nested, err := references.Find(string(schemaJson))
+		if err != nil {
+			return nil, nil, err
+		}
+		pending = append(pending, nested...)
+	}
+	return loaded, creationTimes, nil
+}
+
+func compileSchema(schema string, referenced map[string]string) (*gojsonschema.Schema, error) {
+	schemaJson, err := yaml.YAMLToJSON([]byte(schema))
+	if err != nil {
+		return nil, err
+	}
+	loader := gojsonschema.NewSchemaLoader()
+	for key, referencedJson := range referenced {
+		if err := loader.AddSchema(references.URI(key), gojsonschema.NewStringLoader(referencedJson)); err != nil {
+			return nil, err
+		}
+	}
+	return loader.Compile(gojsonschema.NewStringLoader(string(schemaJson)))
+}
@@ -0,0 +1,47 @@
+package configschema
+
+import (
+	"context"
+
+	"github.com/jtomic1/config-schema-service/internal/rbac"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+func (s *Server) SetRoleBinding(ctx context.Context, in *pb.SetRoleBindingRequest) (*pb.SetRoleBindingResponse, error) {
+	_, err := validators.IsSetRoleBindingRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	if err := s.repo.SetRoleBinding(ctx, in.GetBinding()); err != nil {
+		return nil, repositoryError(err, "Error while saving role binding!")
+	}
+	return &pb.SetRoleBindingResponse{
+		Status:  0,
+		Message: "Role binding saved successfully!",
+	}, nil
+}
+
+// ListRoleBindings returns the bindings of the requested namespace, including
+// bindings to all namespaces, or every binding if no namespace is given.
+func (s *Server) ListRoleBindings(ctx context.Context, in *pb.ListRoleBindingsRequest) (*pb.ListRoleBindingsResponse, error) {
+	_, err := validators.IsListRoleBindingsRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	stored, err := s.repo.ListRoleBindings(ctx, "")
+	if err != nil {
+		return nil, repositoryError(err, "Error while retrieving role bindings!")
+	}
+	bindings := make([]*pb.RoleBinding, 0, len(stored))
+	for _, binding := range stored {
+		if in.GetNamespace() == "" || binding.GetNamespace() == in.GetNamespace() || binding.GetNamespace() == rbac.AllNamespaces {
+			bindings = append(bindings, binding)
+		}
+	}
+	return &pb.ListRoleBindingsResponse{
+		Status:   0,
+		Message:  "Role bindings retrieved successfully!",
+		Bindings: bindings,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package configschema
+
+import (
+	"context"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+const DefaultTombstoneRetention = 30 * 24 * time.Hour
+
+// RestoreConfigSchema restores a deleted schema, unless it was deleted longer
+// than the tombstone retention ago or has been purged.
+func (s *Server) RestoreConfigSchema(ctx context.Context, in *pb.RestoreConfigSchemaRequest) (*pb.RestoreConfigSchemaResponse, error) {
+	_, err := validators.IsRestoreSchemaRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	var deletedAfter time.Time
+	if s.tombstoneRetention > 0 {
+		deletedAfter = time.Now().Add(-s.tombstoneRetention)
+	}
+	if err := s.repo.RestoreConfigSchema(ctx, getConfigSchemaKey(in.GetSchemaDetails()), deletedAfter); err != nil {
+		return nil, repositoryError(err, "Error while restoring schema!")
+	}
+	return &pb.RestoreConfigSchemaResponse{
+		Status:  0,
+		Message: "Schema restored successfully!",
+	}, nil
+}
+
+// PurgeConfigSchema removes a deleted schema for good. Its version stays
+// reserved.
+func (s *Server) PurgeConfigSchema(ctx context.Context, in *pb.PurgeConfigSchemaRequest) (*pb.PurgeConfigSchemaResponse, error) {
+	_, err := validators.IsPurgeSchemaRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	if err := s.repo.PurgeConfigSchema(ctx, getConfigSchemaKey(in.GetSchemaDetails())); err != nil {
+		return nil, repositoryError(err, "Error while purging schema!")
+	}
+	return &pb.PurgeConfigSchemaResponse{
+		Status:  0,
+		Message: "Schema purged successfully!",
+	}, nil
+}
+
+// getDeletedSchema returns the tombstone of the exact version in
+// schemaDetails.
+func (s *Server) getDeletedSchema(ctx context.Context, schemaDetails *pb.ConfigSchemaDetails) (*pb.ConfigSchemaDetails, *pb.ConfigSchemaData, error) {
+	key := getConfigSchemaKey(schemaDetails)
+	deleted, err := s.repo.GetDeletedSchemasByPrefix(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, schema := range deleted {
+		if getConfigSchemaKey(schema.GetSchemaDetails()) == key {
+			return schema.GetSchemaDetails(), schema.GetSchemaData(), nil
+		}
+	}
+	return nil, nil, &repository.SchemaNotFoundError{Key: key}
+}
@@ -0,0 +1,259 @@
+package configschema
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"github.com/xeipuuv/gojsonschema"
+	yamlv3 "sigs.k8s.io/yaml/goyaml.v3"
+)
+
+var schemaKeywords = map[string]string{
+	"false":                           "",
+	"required":                        "required",
+	"invalid_type":                    "type",
+	"number_any_of":                   "anyOf",
+	"number_one_of":                   "oneOf",
+	"number_all_of":                   "allOf",
+	"number_not":                      "not",
+	"missing_dependency":              "dependencies",
+	"const":                           "const",
+	"enum":                            "enum",
+	"array_no_additional_items":       "additionalItems",
+	"array_min_items":                 "minItems",
+	"array_max_items":                 "maxItems",
+	"unique":                          "uniqueItems",
+	"contains":                        "contains",
+	"array_min_properties":            "minProperties",
+	"array_max_properties":            "maxProperties",
+	"additional_property_not_allowed": "additionalProperties",
+	"invalid_property_pattern":        "patternProperties",
+	"invalid_property_name":           "propertyNames",
+	"string_gte":                      "minLength",
+	"string_lte":                      "maxLength",
+	"pattern":                         "pattern",
+	"format":                          "format",
+	"multiple_of":                     "multipleOf",
+	"number_gte":                      "minimum",
+	"number_gt":                       "exclusiveMinimum",
+	"number_lte":                      "maximum",
+	"number_lt":                       "exclusiveMaximum",
+	"condition_then":                  "then",
+	"condition_else":                  "else",
+}
+
+func newValidationErrors(result *gojsonschema.Result, schemaDocument interface{}, configuration string) []*pb.ValidationError {
+	var configurationDocument yamlv3.Node
+	if err := yamlv3.Unmarshal([]byte(configuration), &configurationDocument); err != nil {
+		configurationDocument = yamlv3.Node{}
+	}
+	validationErrors := make([]*pb.ValidationError, len(result.Errors()))
+	for i, resultErr := range result.Errors() {
+		instanceTokens := getInstanceTokens(resultErr.Context())
+		keyword := schemaKeywords[resultErr.Type()]
+		value, err := json.Marshal(resultErr.Value())
+		if err != nil {
+			value = nil
+		}
+		// Point at the offending key itself rather than at the object containing it.
+		var node *yamlv3.Node
+		if property, ok := resultErr.Details()["property"].(string); ok && keyword == "additionalProperties" {
+			node, _ = locateYamlNode(&configurationDocument, append(instanceTokens, property))
+		} else {
+			_, node = locateYamlNode(&configurationDocument, instanceTokens)
+		}
+		validationErrors[i] = &pb.ValidationError{
+			InstancePath: toJsonPointer(instanceTokens),
+			Keyword:      keyword,
+			SchemaPath:   getSchemaPath(schemaDocument, instanceTokens, keyword),
+			Message:      resultErr.Description(),
+			Value:        string(value),
+		}
+		if node != nil {
+			validationErrors[i].Line = int32(node.Line)
+			validationErrors[i].Column = int32(node.Column)
+		}
+	}
+	return validationErrors
+}
+
+// locateYamlNode returns the key and value nodes found at the instance location
+// in the original YAML document. The key node is nil for array items and for
+// the document root.
+func locateYamlNode(document *yamlv3.Node, instanceTokens []string) (*yamlv3.Node, *yamlv3.Node) {
+	if document.Kind != yamlv3.DocumentNode || len(document.Content) == 0 {
+		return nil, nil
+	}
+	var key *yamlv3.Node
+	value := document.Content[0]
+	for _, token := range instanceTokens {
+		key, value = findYamlChild(value, token)
+		if value == nil {
+			return nil, nil
+		}
+	}
+	return key, value
+}
+
+func findYamlChild(node *yamlv3.Node, token string) (*yamlv3.Node, *yamlv3.Node) {
+	for node.Kind == yamlv3.AliasNode {
+		node = node.Alias
+	}
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		var merged []*yamlv3.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == token {
+				return node.Content[i], node.Content[i+1]
+			} else if node.Content[i].Tag == "!!merge" && node.Content[i+1].Kind == yamlv3.SequenceNode {
+				merged = append(merged, node.Content[i+1].Content...)
+			} else if node.Content[i].Tag == "!!merge" {
+				merged = append(merged, node.Content[i+1])
+			}
+		}
+		for _, mergedNode := range merged {
+			if key, value := findYamlChild(mergedNode, token); value != nil {
+				return key, value
+			}
+		}
+	case yamlv3.SequenceNode:
+		index, err := strconv.Atoi(token)
+		if err == nil && index >= 0 && index < len(node.Content) {
+			return nil, node.Content[index]
+		}
+	}
+	return nil, nil
+}
+
+func getInstanceTokens(context *gojsonschema.JsonContext) []string {
+	if context == nil {
+		return nil
+	}
+	// A separator that cannot appear in YAML keys keeps keys containing '.' or '/' intact.
+	tokens := strings.Split(context.String("\x00"), "\x00")
+	return tokens[1:]
+}
+
+func toJsonPointer(tokens []string) string {
+	var pointer strings.Builder
+	for _, token := range tokens {
+		token = strings.ReplaceAll(token, "~", "~0")
+		token = strings.ReplaceAll(token, "/", "~1")
+		pointer.WriteString("/" + token)
+	}
+	return pointer.String()
+}
+
+// getSchemaPath follows the instance location through the schema and returns
+// a JSON pointer to the keyword that failed, or an empty string when the
+// subschema cannot be determined statically (e.g. inside anyOf or oneOf).
+func getSchemaPath(schemaDocument interface{}, instanceTokens []string, keyword string) string {
+	if schemaDocument == nil {
+		return ""
+	}
+	path, subschema := resolveRef(schemaDocument, nil, schemaDocument)
+	for _, token := range instanceTokens {
+		object, ok := subschema.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		var next []string
+		if properties, ok := object["properties"].(map[string]interface{}); ok && properties[token] != nil {
+			next = []string{"properties", token}
+		} else if patternProperties, ok := object["patternProperties"].(map[string]interface{}); ok && matchPattern(patternProperties, token) != "" {
+			next = []string{"patternProperties", matchPattern(patternProperties, token)}
+		} else if index, err := strconv.Atoi(token); err == nil && object["items"] != nil {
+			if tuple, ok := object["items"].([]interface{}); ok {
+				if index < len(tuple) {
+					next = []string{"items", token}
+				} else if object["additionalItems"] != nil {
+					next = []string{"additionalItems"}
+				}
+			} else {
+				next = []string{"items"}
+			}
+		} else if _, ok := object["additionalProperties"].(map[string]interface{}); ok {
+			next = []string{"additionalProperties"}
+		}
+		if next == nil {
+			return ""
+		}
+		path = append(path, next...)
+		subschema = lookup(schemaDocument, path)
+		path, subschema = resolveRef(schemaDocument, path, subschema)
+	}
+	if hasExternalRef(subschema) {
+		return ""
+	}
+	if keyword != "" {
+		path = append(path, keyword)
+	}
+	return "#" + toJsonPointer(path)
+}
+
+// hasExternalRef reports whether subschema refers to another document, such
+// as a schema stored in the registry, whose keywords are not part of the path.
+func hasExternalRef(subschema interface{}) bool {
+	object, _ := subschema.(map[string]interface{})
+	ref, ok := object["$ref"].(string)
+	return ok && !strings.HasPrefix(ref, "#")
+}
+
+func matchPattern(patternProperties map[string]interface{}, token string) string {
+	for pattern := range patternProperties {
+		if matched, err := regexp.MatchString(pattern, token); err == nil && matched {
+			return pattern
+		}
+	}
+	return ""
+}
+
+func resolveRef(schemaDocument interface{}, path []string, subschema interface{}) ([]string, interface{}) {
+	for i := 0; i < 32; i++ {
+		object, ok := subschema.(map[string]interface{})
+		if !ok {
+			return path, subschema
+		}
+		ref, ok := object["$ref"].(string)
+		if !ok || !strings.HasPrefix(ref, "#") {
+			return path, subschema
+		}
+		path = parseJsonPointer(strings.TrimPrefix(ref, "#"))
+		subschema = lookup(schemaDocument, path)
+	}
+	return path, subschema
+}
+
+func parseJsonPointer(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		token = strings.ReplaceAll(token, "~1", "/")
+		tokens[i] = strings.ReplaceAll(token, "~0", "~")
+	}
+	return tokens
+}
+
+func lookup(document interface{}, path []string) interface{} {
+	current := document
+	for _, token := range path {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			current = node[token]
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil
+			}
+			current = node[index]
+		default:
+			return nil
+		}
+	}
+	return current
+}
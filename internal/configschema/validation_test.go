@@ -0,0 +1,216 @@
+package configschema
+
+import (
+	"testing"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"sigs.k8s.io/yaml"
+)
+
+func validate(t *testing.T, schema string, configuration string) []*pb.ValidationError {
+	t.Helper()
+	compiled, err := compileSchema(schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var document interface{}
+	if err := yaml.Unmarshal([]byte(schema), &document); err != nil {
+		t.Fatal(err)
+	}
+	result, err := validateConfiguration(configuration, compiled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newValidationErrors(result, document, configuration)
+}
+
+const serversSchema = `type: object
+required: [name]
+additionalProperties: false
+properties:
+  name:
+    type: string
+  servers:
+    type: array
+    items:
+      $ref: "#/definitions/server"
+  labels:
+    type: object
+    additionalProperties:
+      type: string
+definitions:
+  server:
+    type: object
+    properties:
+      port:
+        type: integer
+        maximum: 65535
+`
+
+func TestValidationErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration string
+		want          []*pb.ValidationError
+	}{
+		{
+			name:          "valid",
+			configuration: "name: db\nservers:\n- port: 80\n",
+		},
+		{
+			name:          "nested array items",
+			configuration: "name: db\nservers:\n- port: 80\n- port: http\n",
+			want:          []*pb.ValidationError{{InstancePath: "/servers/1/port", Keyword: "type", SchemaPath: "#/definitions/server/properties/port/type", Value: `"http"`}},
+		},
+		{
+			name:          "nested array items in JSON",
+			configuration: `{"name": "db", "servers": [{"port": 80}, {"port": 70000}]}`,
+			want:          []*pb.ValidationError{{InstancePath: "/servers/1/port", Keyword: "maximum", SchemaPath: "#/definitions/server/properties/port/maximum", Value: "70000"}},
+		},
+		{
+			name:          "required",
+			configuration: "servers: []\n",
+			want:          []*pb.ValidationError{{InstancePath: "", Keyword: "required", SchemaPath: "#/required"}},
+		},
+		{
+			name:          "required in JSON",
+			configuration: `{"servers": []}`,
+			want:          []*pb.ValidationError{{InstancePath: "", Keyword: "required", SchemaPath: "#/required"}},
+		},
+		{
+			name:          "additional properties",
+			configuration: "name: db\nport: 80\n",
+			want:          []*pb.ValidationError{{InstancePath: "", Keyword: "additionalProperties", SchemaPath: "#/additionalProperties"}},
+		},
+		{
+			name:          "additional properties subschema",
+			configuration: "name: db\nlabels:\n  team/owner: 7\n",
+			want:          []*pb.ValidationError{{InstancePath: "/labels/team~1owner", Keyword: "type", SchemaPath: "#/properties/labels/additionalProperties/type", Value: "7"}},
+		},
+		{
+			name:          "every error",
+			configuration: `{"servers": [{"port": "http"}], "port": 80}`,
+			want: []*pb.ValidationError{
+				{InstancePath: "", Keyword: "required", SchemaPath: "#/required"},
+				{InstancePath: "", Keyword: "additionalProperties", SchemaPath: "#/additionalProperties"},
+				{InstancePath: "/servers/0/port", Keyword: "type", SchemaPath: "#/definitions/server/properties/port/type", Value: `"http"`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validate(t, serversSchema, tt.configuration)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Got %d errors %v, want %d", len(got), got, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got[i].GetInstancePath() != want.InstancePath || got[i].GetKeyword() != want.Keyword || got[i].GetSchemaPath() != want.SchemaPath {
+					t.Errorf("Error %d: got %s %s %s, want %s %s %s", i, got[i].GetInstancePath(), got[i].GetKeyword(), got[i].GetSchemaPath(), want.InstancePath, want.Keyword, want.SchemaPath)
+				}
+				if want.Value != "" && got[i].GetValue() != want.Value {
+					t.Errorf("Error %d: got value %s, want %s", i, got[i].GetValue(), want.Value)
+				}
+				if got[i].GetMessage() == "" {
+					t.Errorf("Error %d has no message", i)
+				}
+			}
+		})
+	}
+}
+
+const serverPairSchema = `type: object
+additionalProperties: false
+properties:
+  defaults:
+    type: object
+  primary:
+    $ref: "#/definitions/server"
+  replica:
+    $ref: "#/definitions/server"
+definitions:
+  server:
+    type: object
+    required: [host]
+    properties:
+      host:
+        type: string
+      port:
+        type: integer
+`
+
+func TestValidationErrorPositions(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration string
+		instancePath  string
+		line, column  int32
+	}{
+		{
+			name:          "plain keys",
+			configuration: "primary:\n  host: a\n  port: http\n",
+			instancePath:  "/primary/port",
+			line:          3,
+			column:        9,
+		},
+		{
+			name:          "flow mapping",
+			configuration: "primary: {host: a, port: http}\n",
+			instancePath:  "/primary/port",
+			line:          1,
+			column:        26,
+		},
+		{
+			name:          "alias",
+			configuration: "defaults: &defaults\n  host: a\n  port: http\nprimary: *defaults\n",
+			instancePath:  "/primary/port",
+			line:          3,
+			column:        9,
+		},
+		{
+			name:          "merge key",
+			configuration: "defaults: &defaults\n  port: http\nreplica:\n  <<: *defaults\n  host: b\n",
+			instancePath:  "/replica/port",
+			line:          2,
+			column:        9,
+		},
+		{
+			name:          "merge key overridden",
+			configuration: "defaults: &defaults\n  port: 80\nreplica:\n  <<: *defaults\n  host: b\n  port: http\n",
+			instancePath:  "/replica/port",
+			line:          6,
+			column:        9,
+		},
+		{
+			name:          "unknown key",
+			configuration: "primary:\n  host: a\nbackup:\n  host: b\n",
+			instancePath:  "",
+			line:          3,
+			column:        1,
+		},
+		{
+			name:          "missing required key",
+			configuration: "primary:\n  port: 80\n",
+			instancePath:  "/primary",
+			line:          2,
+			column:        3,
+		},
+		{
+			name:          "JSON",
+			configuration: `{"primary": {"host": "a", "port": "http"}}`,
+			instancePath:  "/primary/port",
+			line:          1,
+			column:        35,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validate(t, serverPairSchema, tt.configuration)
+			if len(got) != 1 {
+				t.Fatalf("Got errors %v, want one", got)
+			}
+			if got[0].GetInstancePath() != tt.instancePath || got[0].GetLine() != tt.line || got[0].GetColumn() != tt.column {
+				t.Errorf("Got %s at %d:%d, want %s at %d:%d", got[0].GetInstancePath(), got[0].GetLine(), got[0].GetColumn(), tt.instancePath, tt.line, tt.column)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package configschema
+
+import (
+	"context"
+
+	"github.com/jtomic1/config-schema-service/internal/schemadiff"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	"github.com/jtomic1/config-schema-service/internal/versions"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"golang.org/x/mod/semver"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+const initialVersion = "v1.0.0"
+
+var versionBumps = map[versions.Bump]pb.VersionBump{
+	versions.Patch: pb.VersionBump_PATCH,
+	versions.Minor: pb.VersionBump_MINOR,
+	versions.Major: pb.VersionBump_MAJOR,
+}
+
+func (s *Server) SuggestNextVersion(ctx context.Context, in *pb.SuggestNextVersionRequest) (*pb.SuggestNextVersionResponse, error) {
+	_, err := validators.IsSuggestNextVersionRequestValid(in)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	prefix := getConfigSchemaPrefix(in.GetSchemaDetails()) + "/"
+	stored, err := s.repo.GetSchemaDetailsByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, repositoryError(err, "Error while suggesting version!")
+	}
+	// Deleted versions stay reserved, so suggestions have to succeed them too.
+	reserved, err := s.repo.GetLatestVersionByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, repositoryError(err, "Error while suggesting version!")
+	}
+	if len(stored) == 0 {
+		suggested := initialVersion
+		if reserved != "" {
+			suggested = versions.Increment(reserved, versions.Major)
+		}
+		return &pb.SuggestNextVersionResponse{
+			Status:           0,
+			Message:          "No versions of the schema found!",
+			SuggestedVersion: suggested,
+		}, nil
+	}
+	latest := stored[len(stored)-1]
+	changes, err := s.compareWithStored(ctx, latest, in.GetSchema())
+	if err != nil {
+		return nil, repositoryError(err, "Error while suggesting version!")
+	}
+	required := requiredBump(latest.GetVersion(), changes)
+	suggested := versions.Increment(latest.GetVersion(), required)
+	if semver.Compare(suggested, reserved) != 1 {
+		suggested = versions.Increment(reserved, required)
+	}
+	return &pb.SuggestNextVersionResponse{
+		Status:           0,
+		Message:          "Version suggested successfully!",
+		SuggestedVersion: suggested,
+		LatestVersion:    latest.GetVersion(),
+		RequiredBump:     versionBumps[required],
+	}, nil
+}
+
+// requiredBump returns the smallest bump which reflects the changes made
+// since latest: major for breaking changes, minor for additions and patch
+// otherwise. While the major version is zero the schema is not considered
+// stable yet, so breaking changes only need a minor bump and additions a
+// patch bump.
+func requiredBump(latest string, changes []schemadiff.Change) versions.Bump {
+	var breaking, additive bool
+	for _, change := range changes {
+		breaking = breaking || change.Narrowing
+		additive = additive || change.Widening
+	}
+	initialDevelopment := semver.Major(latest) == "v0"
+	switch {
+	case breaking && !initialDevelopment:
+		return versions.Major
+	case breaking, additive && !initialDevelopment:
+		return versions.Minor
+	default:
+		return versions.Patch
+	}
+}
+
+// checkVersionBump returns the breaking changes between the latest stored
+// version and schema if version does not bump the latest version enough to
+// reflect them, along with the bump that is required.
+func (s *Server) checkVersionBump(ctx context.Context, latest *pb.ConfigSchemaDetails, version string, schema string) (versions.Bump, []schemadiff.Change, error) {
+	changes, err := s.compareWithStored(ctx, latest, schema)
+	if err != nil {
+		return versions.NoBump, nil, err
+	}
+	var breaking []schemadiff.Change
+	for _, change := range changes {
+		if change.Narrowing {
+			breaking = append(breaking, change)
+		}
+	}
+	required := requiredBump(latest.GetVersion(), changes)
+	if len(breaking) == 0 || versions.BumpBetween(latest.GetVersion(), version) >= required {
+		return required, nil, nil
+	}
+	return required, breaking, nil
+}
+
+func versionBumpError(latest string, required versions.Bump, breaking []schemadiff.Change) error {
+	violations := make([]compatibilityViolation, len(breaking))
+	for i, change := range breaking {
+		violations[i] = compatibilityViolation{
+			version: latest,
+			change:  change,
+		}
+	}
+	suggested := versions.Increment(latest, required)
+	descriptions, preconditionFailure := newPreconditionFailure(violations)
+	return newStatusError(codes.FailedPrecondition,
+		"Schema contains breaking changes and requires a "+required.String()+" version bump from '"+latest+"', e.g. '"+suggested+"'! "+descriptions,
+		preconditionFailure,
+		&errdetails.ErrorInfo{
+			Reason: "VERSION_BUMP_REQUIRED",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"latest_version":    latest,
+				"required_bump":     required.String(),
+				"suggested_version": suggested,
+			},
+		},
+	)
+}
@@ -0,0 +1,36 @@
+package configschema
+
+import (
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	"github.com/jtomic1/config-schema-service/internal/validators"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchConfigSchemas streams an event for every version of a schema that is
+// saved, updated or deleted. Clients resume after a reconnect by passing the revision
+// of the last event they received plus one as start_revision.
+func (s *Server) WatchConfigSchemas(in *pb.WatchConfigSchemasRequest, stream pb.ConfigSchemaService_WatchConfigSchemasServer) error {
+	_, err := validators.IsWatchConfigSchemasRequestValid(in)
+	if err != nil {
+		return invalidArgumentError(err)
+	}
+	watcher, ok := s.repo.(repository.SchemaWatcher)
+	if !ok {
+		return status.Error(codes.Unimplemented, "Watching schemas is not supported by the storage backend!")
+	}
+	prefix := getConfigSchemaPrefix(in.GetSchemaDetails()) + "/"
+	var sendErr error
+	err = watcher.WatchSchemas(stream.Context(), prefix, in.GetStartRevision(), func(event *pb.SchemaEvent) error {
+		if event.GetType() != pb.SchemaEventType_CREATED {
+			s.cache.remove(getConfigSchemaKey(event.GetSchemaDetails()))
+		}
+		sendErr = stream.Send(event)
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	return repositoryError(err, "Error while watching schemas!")
+}
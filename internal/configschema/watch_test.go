@@ -0,0 +1,175 @@
+package configschema
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchStream records the events sent to it and cancels its context once
+// limit events have been sent.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	limit  int
+	events []*pb.SchemaEvent
+}
+
+func newWatchStream(limit int) *watchStream {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	return &watchStream{ctx: ctx, cancel: cancel, limit: limit}
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(event *pb.SchemaEvent) error {
+	s.events = append(s.events, event)
+	if len(s.events) == s.limit {
+		s.cancel()
+	}
+	return nil
+}
+
+// openEtcdRepository returns a repository under a key prefix of its own and
+// the current revision of etcd, skipping the test if etcd is unavailable.
+func openEtcdRepository(t *testing.T) (*repository.EtcdRepository, int64) {
+	t.Helper()
+	endpoints := os.Getenv("ETCD_ENDPOINTS")
+	if endpoints == "" {
+		endpoints = "localhost:2379"
+	}
+	config := repository.EtcdConfig{
+		Endpoints:      strings.Split(endpoints, ","),
+		DialTimeout:    time.Second,
+		RequestTimeout: 5 * time.Second,
+		KeyPrefix:      fmt.Sprintf("/watch-%d-%08x/", time.Now().UnixNano(), rand.Uint32()),
+	}
+	cli, err := clientv3.New(clientv3.Config{Endpoints: config.Endpoints, DialTimeout: config.DialTimeout})
+	if err != nil {
+		t.Skipf("etcd is unavailable: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, err := cli.Get(ctx, config.KeyPrefix)
+	if err != nil {
+		cli.Close()
+		t.Skipf("etcd is unavailable: %v", err)
+	}
+	repo, err := repository.NewClient(config)
+	if err != nil {
+		cli.Close()
+		t.Skipf("etcd is unavailable: %v", err)
+	}
+	t.Cleanup(func() {
+		cli.Delete(context.Background(), config.KeyPrefix, clientv3.WithPrefix())
+		cli.Close()
+		repo.Close()
+	})
+	return repo, res.Header.Revision
+}
+
+func TestWatchConfigSchemasRequiresWatchingBackend(t *testing.T) {
+	s := NewServer(repository.NewMemoryRepository())
+	stream := newWatchStream(1)
+	defer stream.cancel()
+	err := s.WatchConfigSchemas(&pb.WatchConfigSchemasRequest{User: testUser, SchemaDetails: testDetails("team", "db", "")}, stream)
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("Got %v watching the memory repository, want Unimplemented", err)
+	}
+	err = s.WatchConfigSchemas(&pb.WatchConfigSchemasRequest{User: testUser, SchemaDetails: testDetails("team", "db", ""), StartRevision: -1}, stream)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Got %v for a negative start revision, want InvalidArgument", err)
+	}
+}
+
+func TestWatchConfigSchemas(t *testing.T) {
+	ctx := context.Background()
+	repo, revision := openEtcdRepository(t)
+	watching, changing := NewServer(repo), NewServer(repo)
+	details := testDetails("team", "db", "v1.0.0")
+	saveTestSchema(t, changing, details, hostPortSchema, false)
+	saveTestSchema(t, changing, testDetails("other", "db", "v1.0.0"), hostPortSchema, false)
+	// Validating caches the schema on the watching server.
+	if _, err := watching.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{User: testUser, SchemaDetails: details, Configuration: "port: 80"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := changing.DeleteConfigSchema(ctx, &pb.DeleteConfigSchemaRequest{User: testUser, SchemaDetails: details}); err != nil {
+		t.Fatal(err)
+	}
+	if stats := watching.SchemaCacheStats(); stats.Entries != 1 {
+		t.Fatalf("Got cache stats %+v, want the schema to be cached", stats)
+	}
+
+	stream := newWatchStream(2)
+	defer stream.cancel()
+	err := watching.WatchConfigSchemas(&pb.WatchConfigSchemasRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("team", "db", ""),
+		StartRevision: revision + 1,
+	}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("Got %v, want the watch to end when the client goes away", err)
+	}
+	events := stream.events
+	if len(events) != 2 || events[0].GetType() != pb.SchemaEventType_CREATED || events[1].GetType() != pb.SchemaEventType_DELETED ||
+		events[0].GetUser().GetUsername() != testUser.GetUsername() || events[0].GetSchemaDetails().GetVersion() != "v1.0.0" ||
+		events[0].GetRevision() <= revision || events[1].GetRevision() <= events[0].GetRevision() {
+		t.Fatalf("Got events %v, want team/db/v1.0.0 to be created and deleted", events)
+	}
+	if stats := watching.SchemaCacheStats(); stats.Entries != 0 {
+		t.Errorf("Got cache stats %+v, want the deleted schema to be evicted", stats)
+	}
+}
+
+func TestWatchConfigSchemasEvictsUpdatedSchemas(t *testing.T) {
+	ctx := context.Background()
+	repo, revision := openEtcdRepository(t)
+	watching, changing := NewServer(repo), NewServer(repo)
+	details := testDetails("team", "db", "v1.0.0")
+	saveTestSchema(t, changing, details, hostPortSchema, false)
+	if _, err := watching.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{User: testUser, SchemaDetails: details, Configuration: "port: 80"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := changing.SetLifecycleState(ctx, &pb.SetLifecycleStateRequest{
+		User:          testUser,
+		SchemaDetails: details,
+		State:         pb.LifecycleState_DEPRECATED,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	stream := newWatchStream(2)
+	defer stream.cancel()
+	err := watching.WatchConfigSchemas(&pb.WatchConfigSchemasRequest{
+		User:          testUser,
+		SchemaDetails: testDetails("team", "db", ""),
+		StartRevision: revision + 1,
+	}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("Got %v, want the watch to end when the client goes away", err)
+	}
+	if events := stream.events; len(events) != 2 || events[1].GetType() != pb.SchemaEventType_UPDATED {
+		t.Fatalf("Got events %v, want team/db/v1.0.0 to be created and updated", events)
+	}
+	if stats := watching.SchemaCacheStats(); stats.Entries != 0 {
+		t.Errorf("Got cache stats %+v, want the updated schema to be evicted", stats)
+	}
+	resp, err := watching.ValidateConfiguration(ctx, &pb.ValidateConfigurationRequest{User: testUser, SchemaDetails: details, Configuration: "port: 80"})
+	if err != nil || len(resp.GetWarnings()) != 1 {
+		t.Errorf("Got %v, %v, want a deprecation warning from the watching server", resp, err)
+	}
+}
@@ -0,0 +1,268 @@
+package rbac
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jtomic1/config-schema-service/internal/auth"
+	"github.com/jtomic1/config-schema-service/internal/references"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A role binding to AllNamespaces applies to every namespace, and a binding
+// for AllUsers applies to every authenticated user.
+const (
+	AllNamespaces = "*"
+	AllUsers      = "*"
+)
+
+// requiredRoles maps the methods of ConfigSchemaService onto the role a caller
+// needs in every namespace named by the request. Each role includes the ones
+// below it. Methods missing from the map require ADMIN.
+var requiredRoles = map[string]pb.Role{
+	"GetConfigSchema":             pb.Role_READER,
+	"GetConfigSchemaVersions":     pb.Role_READER,
+	"ListNamespaces":              pb.Role_READER,
+	"ListSchemas":                 pb.Role_READER,
+	"GetCompatibilityMode":        pb.Role_READER,
+	"SuggestNextVersion":          pb.Role_READER,
+	"DiffConfigSchemas":           pb.Role_READER,
+	"WatchConfigSchemas":          pb.Role_READER,
+	"ValidateConfiguration":       pb.Role_VALIDATOR,
+	"ValidateConfigurations":      pb.Role_VALIDATOR,
+	"ValidateConfigurationStream": pb.Role_VALIDATOR,
+	"SaveConfigSchema":            pb.Role_PUBLISHER,
+	"DeleteConfigSchema":          pb.Role_PUBLISHER,
+	"SetLifecycleState":           pb.Role_PUBLISHER,
+	"RestoreConfigSchema":         pb.Role_ADMIN,
+	"PurgeConfigSchema":           pb.Role_ADMIN,
+	"SetCompatibilityMode":        pb.Role_ADMIN,
+	"SetRoleBinding":              pb.Role_ADMIN,
+	"ListRoleBindings":            pb.Role_ADMIN,
+	"ListAuditEvents":             pb.Role_ADMIN,
+}
+
+var servicePrefix = "/" + pb.ConfigSchemaService_ServiceDesc.ServiceName + "/"
+
+type Authorizer struct {
+	repo   repository.SchemaRepository
+	admins map[string]bool
+}
+
+// NewAuthorizer returns an authorizer reading role bindings from repo. The
+// given admins are administrators of every namespace regardless of the stored
+// bindings, so that the first bindings can be created.
+func NewAuthorizer(repo repository.SchemaRepository, admins []string) *Authorizer {
+	a := &Authorizer{
+		repo:   repo,
+		admins: make(map[string]bool, len(admins)),
+	}
+	for _, admin := range admins {
+		a.admins[admin] = true
+	}
+	return a
+}
+
+// Authorize checks that the principal of ctx holds the role required by
+// fullMethod in every namespace of req. An empty namespace stands for all
+// namespaces. Requests without a namespace, such as ListNamespaces, need the
+// role in at least one namespace.
+func (a *Authorizer) Authorize(ctx context.Context, fullMethod string, req interface{}) error {
+	if !strings.HasPrefix(fullMethod, servicePrefix) {
+		return nil
+	}
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "Request is not authenticated!")
+	}
+	required, ok := requiredRoles[strings.TrimPrefix(fullMethod, servicePrefix)]
+	if !ok {
+		required = pb.Role_ADMIN
+	}
+	roles, err := a.getRoles(ctx, principal.Username)
+	if err != nil {
+		return status.Error(codes.Internal, "Error while retrieving role bindings!")
+	}
+	var namespaces, referencedNamespaces []string
+	if msg, ok := req.(proto.Message); ok {
+		namespaces = RequestNamespaces(msg)
+		referencedNamespaces = ReferencedNamespaces(msg)
+	}
+	for _, namespace := range referencedNamespaces {
+		if roleIn(roles, namespace) < pb.Role_READER {
+			return permissionDeniedError(principal, namespace, pb.Role_READER)
+		}
+	}
+	if len(namespaces) == 0 {
+		for namespace := range roles {
+			if roleIn(roles, namespace) >= required {
+				return nil
+			}
+		}
+		return permissionDeniedError(principal, "", required)
+	}
+	for _, namespace := range namespaces {
+		if roleIn(roles, namespace) < required {
+			return permissionDeniedError(principal, namespace, required)
+		}
+	}
+	return nil
+}
+
+// getRoles returns the highest role of the user per namespace.
+func (a *Authorizer) getRoles(ctx context.Context, username string) (map[string]pb.Role, error) {
+	roles := make(map[string]pb.Role)
+	if a.admins[username] {
+		roles[AllNamespaces] = pb.Role_ADMIN
+	}
+	for _, subject := range []string{username, AllUsers} {
+		bindings, err := a.repo.ListRoleBindings(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+		for _, binding := range bindings {
+			if binding.GetRole() > roles[binding.GetNamespace()] {
+				roles[binding.GetNamespace()] = binding.GetRole()
+			}
+		}
+	}
+	return roles, nil
+}
+
+func roleIn(roles map[string]pb.Role, namespace string) pb.Role {
+	if roles[AllNamespaces] > roles[namespace] {
+		return roles[AllNamespaces]
+	}
+	return roles[namespace]
+}
+
+// RequestNamespaces returns the distinct values of every string field named
+// "namespace" in req and its nested messages, e.g. of ConfigSchemaDetails,
+// ListSchemasRequest and RoleBinding. Empty namespaces are returned as
+// AllNamespaces.
+func RequestNamespaces(req proto.Message) []string {
+	return requestNamespaces(req.ProtoReflect(), make(map[string]bool))
+}
+
+func requestNamespaces(msg protoreflect.Message, seen map[string]bool) []string {
+	var namespaces []string
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		switch {
+		case field.Name() == "namespace" && field.Kind() == protoreflect.StringKind && field.Cardinality() != protoreflect.Repeated:
+			namespace := msg.Get(field).String()
+			if namespace == "" {
+				namespace = AllNamespaces
+			}
+			if !seen[namespace] {
+				seen[namespace] = true
+				namespaces = append(namespaces, namespace)
+			}
+		case field.Kind() != protoreflect.MessageKind || field.IsMap() || !msg.Has(field):
+		case field.IsList():
+			list := msg.Get(field).List()
+			for j := 0; j < list.Len(); j++ {
+				namespaces = append(namespaces, requestNamespaces(list.Get(j).Message(), seen)...)
+			}
+		default:
+			namespaces = append(namespaces, requestNamespaces(msg.Get(field).Message(), seen)...)
+		}
+	}
+	return namespaces
+}
+
+// schemaFields names the request fields holding a schema, whose references
+// may name other namespaces.
+var schemaFields = []protoreflect.Name{"schema", "candidate_schema"}
+
+// ReferencedNamespaces returns the distinct namespaces of the schemas
+// referenced by the schema fields of req. Schemas which cannot be parsed are
+// skipped, since they are rejected by the request validation.
+func ReferencedNamespaces(req proto.Message) []string {
+	msg := req.ProtoReflect()
+	seen := make(map[string]bool)
+	var namespaces []string
+	for _, name := range schemaFields {
+		field := msg.Descriptor().Fields().ByName(name)
+		if field == nil || field.Kind() != protoreflect.StringKind || field.Cardinality() == protoreflect.Repeated {
+			continue
+		}
+		referencedKeys, err := references.Find(msg.Get(field).String())
+		if err != nil {
+			continue
+		}
+		for _, key := range referencedKeys {
+			namespace := key[:strings.Index(key, "/")]
+			if !seen[namespace] {
+				seen[namespace] = true
+				namespaces = append(namespaces, namespace)
+			}
+		}
+	}
+	return namespaces
+}
+
+func permissionDeniedError(principal *auth.Principal, namespace string, required pb.Role) error {
+	message := "User '" + principal.Username + "' needs the " + required.String() + " role in namespace '" + namespace + "'!"
+	switch namespace {
+	case "":
+		message = "User '" + principal.Username + "' needs the " + required.String() + " role in at least one namespace!"
+	case AllNamespaces:
+		message = "User '" + principal.Username + "' needs the " + required.String() + " role in every namespace!"
+	}
+	st := status.New(codes.PermissionDenied, message)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "ROLE_REQUIRED",
+		Domain: "config-schema-service",
+		Metadata: map[string]string{
+			"namespace":     namespace,
+			"required_role": required.String(),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.Authorize(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor authorizes every message received on a stream, so each
+// request of ValidateConfigurationStream is checked on its own.
+func (a *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			authorizer:   a,
+			fullMethod:   info.FullMethod,
+		})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	authorizer *Authorizer
+	fullMethod string
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authorizer.Authorize(s.Context(), s.fullMethod, m)
+}
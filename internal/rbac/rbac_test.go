@@ -0,0 +1,145 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jtomic1/config-schema-service/internal/auth"
+	"github.com/jtomic1/config-schema-service/internal/repository"
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestAuthorizer(t *testing.T, admins []string, bindings ...*pb.RoleBinding) *Authorizer {
+	t.Helper()
+	repo := repository.NewMemoryRepository()
+	t.Cleanup(repo.Close)
+	for _, binding := range bindings {
+		if err := repo.SetRoleBinding(context.Background(), binding); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return NewAuthorizer(repo, admins)
+}
+
+func expectAuthorized(t *testing.T, a *Authorizer, username string, method string, req proto.Message, want codes.Code) {
+	t.Helper()
+	ctx := auth.NewContext(context.Background(), &auth.Principal{Username: username})
+	err := a.Authorize(ctx, servicePrefix+method, req)
+	if status.Code(err) != want {
+		t.Errorf("%s of %s: got %v, want %v", method, username, err, want)
+	}
+}
+
+func TestAuthorizeReferencedNamespaces(t *testing.T) {
+	a := newTestAuthorizer(t, nil,
+		&pb.RoleBinding{Username: "alice", Namespace: "payments", Role: pb.Role_PUBLISHER},
+		&pb.RoleBinding{Username: "alice", Namespace: "common", Role: pb.Role_READER},
+		&pb.RoleBinding{Username: "bob", Namespace: "payments", Role: pb.Role_PUBLISHER},
+	)
+	details := &pb.ConfigSchemaDetails{Namespace: "payments", SchemaName: "db", Version: "v1.0.0"}
+	referencing := "properties:\n  port:\n    $ref: quasar://common/network/v1.0.0#/definitions/port\n"
+	save := &pb.SaveConfigSchemaRequest{SchemaDetails: details, Schema: referencing}
+	expectAuthorized(t, a, "alice", "SaveConfigSchema", save, codes.OK)
+	expectAuthorized(t, a, "bob", "SaveConfigSchema", save, codes.PermissionDenied)
+
+	diff := &pb.DiffConfigSchemasRequest{BaseDetails: details, CandidateSchema: referencing}
+	expectAuthorized(t, a, "alice", "DiffConfigSchemas", diff, codes.OK)
+	expectAuthorized(t, a, "bob", "DiffConfigSchemas", diff, codes.PermissionDenied)
+
+	local := &pb.SaveConfigSchemaRequest{SchemaDetails: details, Schema: "type: object\n"}
+	expectAuthorized(t, a, "bob", "SaveConfigSchema", local, codes.OK)
+}
+
+func TestReferencedNamespaces(t *testing.T) {
+	req := &pb.SaveConfigSchemaRequest{
+		Schema: "properties:\n" +
+			"  a:\n    $ref: quasar://common/network/v1.0.0#/definitions/port\n" +
+			"  b:\n    $ref: quasar://common/network/v1.1.0#/definitions/host\n" +
+			"  c:\n    $ref: quasar://shared/tls/v2.0.0#/definitions/certificate\n" +
+			"  d:\n    $ref: '#/definitions/local'\n",
+	}
+	got := ReferencedNamespaces(req)
+	if len(got) != 2 || got[0] != "common" || got[1] != "shared" {
+		t.Errorf("Got referenced namespaces %v, want [common shared]", got)
+	}
+	if got := ReferencedNamespaces(&pb.SaveConfigSchemaRequest{Schema: "{"}); len(got) != 0 {
+		t.Errorf("Got referenced namespaces %v of an invalid schema", got)
+	}
+	if got := ReferencedNamespaces(&pb.GetConfigSchemaRequest{}); len(got) != 0 {
+		t.Errorf("Got referenced namespaces %v of a request without schema", got)
+	}
+}
+
+func TestRoleResolution(t *testing.T) {
+	a := newTestAuthorizer(t, []string{"root"},
+		&pb.RoleBinding{Username: "alice", Namespace: "payments", Role: pb.Role_READER},
+		&pb.RoleBinding{Username: "alice", Namespace: "payments", Role: pb.Role_PUBLISHER},
+		&pb.RoleBinding{Username: "bob", Namespace: AllNamespaces, Role: pb.Role_READER},
+		&pb.RoleBinding{Username: AllUsers, Namespace: "shared", Role: pb.Role_VALIDATOR},
+	)
+	tests := []struct {
+		username  string
+		namespace string
+		want      pb.Role
+	}{
+		{"alice", "payments", pb.Role_PUBLISHER},
+		{"alice", "shared", pb.Role_VALIDATOR},
+		{"alice", "billing", pb.Role_ROLE_UNSPECIFIED},
+		{"bob", "billing", pb.Role_READER},
+		{"bob", "shared", pb.Role_VALIDATOR},
+		{"carol", "shared", pb.Role_VALIDATOR},
+		{"root", "billing", pb.Role_ADMIN},
+	}
+	for _, tt := range tests {
+		roles, err := a.getRoles(context.Background(), tt.username)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := roleIn(roles, tt.namespace); got != tt.want {
+			t.Errorf("Role of %s in %s = %v, want %v", tt.username, tt.namespace, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	a := newTestAuthorizer(t, []string{"root"},
+		&pb.RoleBinding{Username: "alice", Namespace: "payments", Role: pb.Role_PUBLISHER},
+		&pb.RoleBinding{Username: "bob", Namespace: AllNamespaces, Role: pb.Role_READER},
+	)
+	payments := &pb.ConfigSchemaDetails{Namespace: "payments", SchemaName: "db", Version: "v1.0.0"}
+	billing := &pb.ConfigSchemaDetails{Namespace: "billing", SchemaName: "db", Version: "v1.0.0"}
+
+	expectAuthorized(t, a, "alice", "SaveConfigSchema", &pb.SaveConfigSchemaRequest{SchemaDetails: payments}, codes.OK)
+	expectAuthorized(t, a, "alice", "SaveConfigSchema", &pb.SaveConfigSchemaRequest{SchemaDetails: billing}, codes.PermissionDenied)
+	expectAuthorized(t, a, "alice", "PurgeConfigSchema", &pb.PurgeConfigSchemaRequest{SchemaDetails: payments}, codes.PermissionDenied)
+	expectAuthorized(t, a, "bob", "GetConfigSchema", &pb.GetConfigSchemaRequest{SchemaDetails: billing}, codes.OK)
+	expectAuthorized(t, a, "bob", "ValidateConfiguration", &pb.ValidateConfigurationRequest{SchemaDetails: billing}, codes.PermissionDenied)
+	expectAuthorized(t, a, "root", "PurgeConfigSchema", &pb.PurgeConfigSchemaRequest{SchemaDetails: billing}, codes.OK)
+
+	// Batches need the role in the namespace of every item.
+	batch := &pb.ValidateConfigurationsRequest{Items: []*pb.ValidationItem{{SchemaDetails: payments}, {SchemaDetails: billing}}}
+	expectAuthorized(t, a, "alice", "ValidateConfigurations", batch, codes.PermissionDenied)
+	batch.Items = batch.Items[:1]
+	expectAuthorized(t, a, "alice", "ValidateConfigurations", batch, codes.OK)
+
+	// Requests without a namespace need the role in at least one namespace,
+	// an empty namespace needs it in every namespace.
+	expectAuthorized(t, a, "alice", "ListNamespaces", &pb.ListNamespacesRequest{}, codes.OK)
+	expectAuthorized(t, a, "carol", "ListNamespaces", &pb.ListNamespacesRequest{}, codes.PermissionDenied)
+	expectAuthorized(t, a, "alice", "ListRoleBindings", &pb.ListRoleBindingsRequest{}, codes.PermissionDenied)
+	expectAuthorized(t, a, "alice", "ListSchemas", &pb.ListSchemasRequest{}, codes.PermissionDenied)
+	expectAuthorized(t, a, "bob", "ListSchemas", &pb.ListSchemasRequest{}, codes.OK)
+
+	// Methods missing from requiredRoles need ADMIN, methods of other
+	// services are not checked.
+	expectAuthorized(t, a, "alice", "UnknownMethod", &pb.GetConfigSchemaRequest{SchemaDetails: payments}, codes.PermissionDenied)
+	if err := a.Authorize(context.Background(), "/grpc.health.v1.Health/Check", nil); err != nil {
+		t.Errorf("Health check was denied: %v", err)
+	}
+	if err := a.Authorize(context.Background(), servicePrefix+"GetConfigSchema", &pb.GetConfigSchemaRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Got %v without principal, want Unauthenticated", err)
+	}
+}
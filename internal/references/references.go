@@ -0,0 +1,73 @@
+package references
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"sigs.k8s.io/yaml"
+)
+
+// Prefix starts every $ref URI which points to a schema stored in the
+// registry, e.g. quasar://namespace/name/v1.2.0#/definitions/Port.
+const Prefix = "quasar://"
+
+func URI(key string) string {
+	return Prefix + key
+}
+
+// KeyFromURI returns the key of the stored schema a registry URI points to.
+// Only exact versions can be referenced, as stored versions are immutable
+// while the version selected by a range changes over time.
+func KeyFromURI(uri string) (string, error) {
+	key := strings.SplitN(strings.TrimPrefix(uri, Prefix), "#", 2)[0]
+	tokens := strings.Split(key, "/")
+	if len(tokens) != 3 || tokens[0] == "" || tokens[1] == "" || !semver.IsValid(tokens[2]) {
+		return "", errors.New("Reference '" + uri + "' must point to a namespace, schema name and SemVer version, e.g. '" + Prefix + "namespace/name/v1.0.0'!")
+	}
+	return key, nil
+}
+
+// Find returns the sorted keys of all stored schemas which schema, given in
+// YAML or JSON, references.
+func Find(schema string) ([]string, error) {
+	var document interface{}
+	if err := yaml.Unmarshal([]byte(schema), &document); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool)
+	if err := findKeys(document, keys); err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func findKeys(node interface{}, keys map[string]bool) error {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for name, value := range n {
+			if ref, ok := value.(string); ok && name == "$ref" && strings.HasPrefix(ref, Prefix) {
+				key, err := KeyFromURI(ref)
+				if err != nil {
+					return err
+				}
+				keys[key] = true
+			} else if err := findKeys(value, keys); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range n {
+			if err := findKeys(item, keys); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package references
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	schema := `
+properties:
+  port:
+    $ref: quasar://common/port/v1.0.0#/definitions/port
+  hosts:
+    type: array
+    items:
+      $ref: quasar://common/host/v2.1.0
+  local:
+    $ref: "#/definitions/local"
+  again:
+    $ref: quasar://common/port/v1.0.0#/definitions/port
+`
+	keys, err := Find(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"common/host/v2.1.0", "common/port/v1.0.0"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Got keys %v, want %v", keys, want)
+	}
+}
+
+func TestKeyFromURIRejectsInvalidReferences(t *testing.T) {
+	for _, uri := range []string{"quasar://common/port", "quasar://common/port/latest", "quasar:///port/v1.0.0", "quasar://a/b/c/v1.0.0"} {
+		if _, err := KeyFromURI(uri); err == nil {
+			t.Errorf("%q was accepted", uri)
+		}
+	}
+}
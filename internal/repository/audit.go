@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+const (
+	auditPrefix = "/audit/"
+	// auditEnd is the first key after every key starting with auditPrefix.
+	auditEnd = "/audit0"
+)
+
+// AuditFilter selects audit events. Empty fields match every event; To is
+// exclusive. After is the ID of the last event of the previous page.
+type AuditFilter struct {
+	Namespace string
+	Username  string
+	From      time.Time
+	To        time.Time
+	After     string
+	Limit     int
+}
+
+func (f AuditFilter) matches(event *pb.AuditEvent) bool {
+	if f.Username != "" && event.GetUser().GetUsername() != f.Username {
+		return false
+	}
+	if f.Namespace == "" {
+		return true
+	}
+	for _, namespace := range event.GetNamespaces() {
+		if namespace == f.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Audit event IDs start with the zero-padded time of the event, so that keys
+// sort chronologically and time ranges map onto key ranges.
+func newAuditEventID(t time.Time) string {
+	return fmt.Sprintf("%020d-%08x", t.UnixNano(), rand.Uint32())
+}
+
+func getAuditEventKey(id string) string {
+	return auditPrefix + id
+}
+
+// getAuditRange returns the first key and the exclusive end key to scan for
+// the events of a filter.
+func getAuditRange(filter AuditFilter) (string, string) {
+	start := auditPrefix
+	if !filter.From.IsZero() {
+		start = auditPrefix + fmt.Sprintf("%020d", filter.From.UnixNano())
+	}
+	if filter.After != "" && getAuditEventKey(filter.After)+"\x00" > start {
+		start = getAuditEventKey(filter.After) + "\x00"
+	}
+	end := auditEnd
+	if !filter.To.IsZero() {
+		end = auditPrefix + fmt.Sprintf("%020d", filter.To.UnixNano())
+	}
+	return start, end
+}
+
+func auditEventExistsError(id string) error {
+	return errors.New("Audit event '" + id + "' already exists!")
+}
+
+func encodeAuditEvent(event *pb.AuditEvent) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+func decodeAuditEvent(value []byte) (*pb.AuditEvent, error) {
+	var event pb.AuditEvent
+	if err := json.Unmarshal(value, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
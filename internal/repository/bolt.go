@@ -0,0 +1,441 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	schemasBucket = []byte("schemas")
+	openTimeout   = 5 * time.Second
+)
+
+var _ SchemaRepository = (*BoltRepository)(nil)
+
+type BoltRepository struct {
+	db *bolt.DB
+}
+
+func NewBoltRepository(path string) (*BoltRepository, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(schemasBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltRepository{
+		db: db,
+	}, nil
+}
+
+func (repo *BoltRepository) Close() {
+	repo.db.Close()
+}
+
+func (repo *BoltRepository) SaveConfigSchema(ctx context.Context, key string, user *pb.User, schema string, state pb.LifecycleState) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bucket := tx.Bucket(schemasBucket)
+		existing := bucket.Get([]byte(key))
+		if err := checkSaveAllowed(key, maxVersion(getBoltVersions(bucket, getSchemaPrefixFromKey(key))), existing); err != nil {
+			return err
+		}
+		referencedKeys, err := findReferences(key, schema)
+		if err != nil {
+			return err
+		}
+		for _, referenced := range referencedKeys {
+			if err := checkReference(referenced, bucket.Get([]byte(referenced))); err != nil {
+				return err
+			}
+		}
+		staleKeys, err := staleReferences(existing, referencedKeys)
+		if err != nil {
+			return err
+		}
+		serializedData, err := encodeSchemaData(user, schema, state)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put([]byte(key), serializedData); err != nil {
+			return err
+		}
+		for _, referenced := range staleKeys {
+			if err := bucket.Delete([]byte(getReferenceKey(referenced, key))); err != nil {
+				return err
+			}
+		}
+		for _, referenced := range referencedKeys {
+			if err := bucket.Put([]byte(getReferenceKey(referenced, key)), []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (repo *BoltRepository) GetConfigSchema(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	value, err := repo.getValue(ctx, key)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return decodeSchemaData(value)
+}
+
+func (repo *BoltRepository) GetConfigSchemaMetadata(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	value, err := repo.getValue(ctx, key)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return decodeSchemaMetadata(value)
+}
+
+func (repo *BoltRepository) getValue(ctx context.Context, key string) ([]byte, error) {
+	var value []byte
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if v := tx.Bucket(schemasBucket).Get([]byte(key)); v != nil {
+			value = append([]byte(nil), v...)
+		}
+		return nil
+	})
+	return value, err
+}
+
+func (repo *BoltRepository) DeleteConfigSchema(ctx context.Context, key string, user *pb.User) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bucket := tx.Bucket(schemasBucket)
+		value := bucket.Get([]byte(key))
+		if value == nil {
+			return &SchemaNotFoundError{Key: key}
+		}
+		var referencedBy []string
+		cursor := bucket.Cursor()
+		prefix := []byte(getReferencesPrefix(key))
+		for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+			referencedBy = append(referencedBy, string(k[len(prefix):]))
+		}
+		if len(referencedBy) > 0 {
+			return &SchemaReferencedError{Key: key, ReferencedBy: referencedBy}
+		}
+		referencedKeys, err := getStoredReferences(value)
+		if err != nil {
+			return err
+		}
+		tombstone, err := encodeTombstone(value, user)
+		if err != nil {
+			return err
+		}
+		for _, referenced := range referencedKeys {
+			if err := bucket.Delete([]byte(getReferenceKey(referenced, key))); err != nil {
+				return err
+			}
+		}
+		if err := bucket.Put([]byte(getTombstoneKey(key)), tombstone); err != nil {
+			return err
+		}
+		return bucket.Delete([]byte(key))
+	})
+}
+
+func (repo *BoltRepository) RestoreConfigSchema(ctx context.Context, key string, deletedAfter time.Time) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bucket := tx.Bucket(schemasBucket)
+		tombstone := bucket.Get([]byte(getTombstoneKey(key)))
+		if tombstone == nil {
+			return &TombstoneNotFoundError{Key: key}
+		}
+		value, err := restoreTombstone(key, tombstone, deletedAfter)
+		if err != nil {
+			return err
+		}
+		referencedKeys, err := getStoredReferences(value)
+		if err != nil {
+			return err
+		}
+		for _, referenced := range referencedKeys {
+			if err := checkReference(referenced, bucket.Get([]byte(referenced))); err != nil {
+				return err
+			}
+		}
+		if err := bucket.Put([]byte(key), value); err != nil {
+			return err
+		}
+		for _, referenced := range referencedKeys {
+			if err := bucket.Put([]byte(getReferenceKey(referenced, key)), []byte{}); err != nil {
+				return err
+			}
+		}
+		return bucket.Delete([]byte(getTombstoneKey(key)))
+	})
+}
+
+func (repo *BoltRepository) PurgeConfigSchema(ctx context.Context, key string) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bucket := tx.Bucket(schemasBucket)
+		tombstone := bucket.Get([]byte(getTombstoneKey(key)))
+		if tombstone == nil {
+			return &TombstoneNotFoundError{Key: key}
+		}
+		purged, err := purgeTombstone(tombstone)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(getTombstoneKey(key)), purged)
+	})
+}
+
+func (repo *BoltRepository) GetSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	return repo.getSchemasByPrefix(ctx, prefix, decodeSchemaData)
+}
+
+func (repo *BoltRepository) GetSchemaMetadataByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	return repo.getSchemasByPrefix(ctx, prefix, decodeSchemaMetadata)
+}
+
+func (repo *BoltRepository) getSchemasByPrefix(ctx context.Context, prefix string, decode func([]byte) (*pb.ConfigSchemaData, error)) ([]*pb.ConfigSchema, error) {
+	var schemas []*pb.ConfigSchema
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cursor := tx.Bucket(schemasBucket).Cursor()
+		for k, v := cursor.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, v = cursor.Next() {
+			schemaData, err := decode(v)
+			if err != nil {
+				return err
+			}
+			schemas = append(schemas, &pb.ConfigSchema{
+				SchemaDetails: getSchemaDetailsFromKey(string(k)),
+				SchemaData:    schemaData,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortSchemasByVersion(schemas)
+	return schemas, nil
+}
+
+func (repo *BoltRepository) GetDeletedSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	var schemas []*pb.ConfigSchema
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		deletedPrefix := []byte(getTombstonesPrefix(prefix))
+		cursor := tx.Bucket(schemasBucket).Cursor()
+		for k, v := cursor.Seek(deletedPrefix); k != nil && bytes.HasPrefix(k, deletedPrefix); k, v = cursor.Next() {
+			schema, err := decodeTombstone(string(k), v)
+			if err != nil {
+				return err
+			}
+			schemas = append(schemas, schema)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortSchemasByVersion(schemas)
+	return schemas, nil
+}
+
+func (repo *BoltRepository) GetLatestVersionByPrefix(ctx context.Context, prefix string) (string, error) {
+	var latest string
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		latest = maxVersion(getBoltVersions(tx.Bucket(schemasBucket), prefix))
+		return nil
+	})
+	return latest, err
+}
+
+func (repo *BoltRepository) GetSchemaDetailsByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchemaDetails, error) {
+	var schemaDetails []*pb.ConfigSchemaDetails
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cursor := tx.Bucket(schemasBucket).Cursor()
+		k, _ := cursor.Seek([]byte(prefix))
+		for k != nil && bytes.HasPrefix(k, []byte(prefix)) {
+			// Bookkeeping keys all start with '/', which schema keys never do.
+			if k[0] == '/' {
+				k, _ = cursor.Seek([]byte("0"))
+				continue
+			}
+			if isSchemaKey(string(k)) {
+				schemaDetails = append(schemaDetails, getSchemaDetailsFromKey(string(k)))
+			}
+			k, _ = cursor.Next()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortSchemaDetails(schemaDetails)
+	return schemaDetails, nil
+}
+
+func (repo *BoltRepository) SetCompatibilityMode(ctx context.Context, prefix string, mode pb.CompatibilityMode) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bucket := tx.Bucket(schemasBucket)
+		if mode == pb.CompatibilityMode_COMPATIBILITY_MODE_UNSPECIFIED {
+			return bucket.Delete([]byte(getCompatibilityModeKey(prefix)))
+		}
+		return bucket.Put([]byte(getCompatibilityModeKey(prefix)), []byte(mode.String()))
+	})
+}
+
+func (repo *BoltRepository) GetCompatibilityMode(ctx context.Context, prefix string) (pb.CompatibilityMode, error) {
+	value, err := repo.getValue(ctx, getCompatibilityModeKey(prefix))
+	if err != nil {
+		return pb.CompatibilityMode_COMPATIBILITY_MODE_UNSPECIFIED, err
+	}
+	return decodeCompatibilityMode(value), nil
+}
+
+func (repo *BoltRepository) SetRoleBinding(ctx context.Context, binding *pb.RoleBinding) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bucket := tx.Bucket(schemasBucket)
+		if binding.GetRole() == pb.Role_ROLE_UNSPECIFIED {
+			return bucket.Delete([]byte(getRoleBindingKey(binding)))
+		}
+		return bucket.Put([]byte(getRoleBindingKey(binding)), []byte(binding.GetRole().String()))
+	})
+}
+
+func (repo *BoltRepository) ListRoleBindings(ctx context.Context, username string) ([]*pb.RoleBinding, error) {
+	var bindings []*pb.RoleBinding
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		prefix := []byte(getRoleBindingsPrefix(username))
+		cursor := tx.Bucket(schemasBucket).Cursor()
+		for k, v := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			bindings = append(bindings, decodeRoleBinding(string(k), v))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortRoleBindings(bindings)
+	return bindings, nil
+}
+
+func (repo *BoltRepository) AppendAuditEvent(ctx context.Context, event *pb.AuditEvent) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		event.Id = newAuditEventID(event.GetTime().AsTime())
+		value, err := encodeAuditEvent(event)
+		if err != nil {
+			return err
+		}
+		bucket := tx.Bucket(schemasBucket)
+		key := []byte(getAuditEventKey(event.GetId()))
+		if bucket.Get(key) != nil {
+			return auditEventExistsError(event.GetId())
+		}
+		return bucket.Put(key, value)
+	})
+}
+
+func (repo *BoltRepository) ListAuditEvents(ctx context.Context, filter AuditFilter) ([]*pb.AuditEvent, error) {
+	var events []*pb.AuditEvent
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		start, end := getAuditRange(filter)
+		cursor := tx.Bucket(schemasBucket).Cursor()
+		for k, v := cursor.Seek([]byte(start)); k != nil && string(k) < end; k, v = cursor.Next() {
+			event, err := decodeAuditEvent(v)
+			if err != nil {
+				return err
+			}
+			if filter.matches(event) {
+				events = append(events, event)
+				if len(events) == filter.Limit {
+					break
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (repo *BoltRepository) SetLifecycleState(ctx context.Context, key string, state pb.LifecycleState, reason string) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bucket := tx.Bucket(schemasBucket)
+		value := bucket.Get([]byte(key))
+		if value == nil {
+			return &SchemaNotFoundError{Key: key}
+		}
+		transitioned, err := transitionSchemaData(key, value, state, reason)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(key), transitioned)
+	})
+}
+
+// getBoltVersions returns the versions saved under prefix, including deleted
+// ones.
+func getBoltVersions(bucket *bolt.Bucket, prefix string) []string {
+	var versions []string
+	cursor := bucket.Cursor()
+	for k, _ := cursor.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, _ = cursor.Next() {
+		versions = append(versions, getSchemaDetailsFromKey(string(k)).GetVersion())
+	}
+	deletedPrefix := []byte(getTombstonesPrefix(prefix))
+	for k, _ := cursor.Seek(deletedPrefix); k != nil && bytes.HasPrefix(k, deletedPrefix); k, _ = cursor.Next() {
+		versions = append(versions, getSchemaDetailsFromKey(getKeyFromTombstoneKey(string(k))).GetVersion())
+	}
+	return versions
+}
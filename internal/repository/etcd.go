@@ -0,0 +1,733 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	"go.etcd.io/etcd/client/pkg/v3/transport"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/namespace"
+)
+
+var keepAliveTime = 10 * time.Second
+
+// reconnectAfterFailures is how many health checks in a row must fail before
+// the client is replaced. A single failed read may be a blip, and replacing
+// the client drops the requests in flight.
+var reconnectAfterFailures = 3
+
+type EtcdConfig struct {
+	Endpoints      []string
+	DialTimeout    time.Duration
+	RequestTimeout time.Duration
+	Username       string
+	Password       string
+	CertFile       string
+	KeyFile        string
+	CAFile         string
+	KeyPrefix      string
+}
+
+var (
+	_ SchemaRepository = (*EtcdRepository)(nil)
+	_ HealthChecker    = (*EtcdRepository)(nil)
+	_ SchemaWatcher    = (*EtcdRepository)(nil)
+)
+
+type EtcdRepository struct {
+	mu       sync.RWMutex
+	client   *clientv3.Client
+	config   EtcdConfig
+	failures int
+	// watchers counts the watches running on each client. Replaced clients
+	// are closed once their last watch ends.
+	watchers map[*clientv3.Client]int
+	replaced map[*clientv3.Client]bool
+}
+
+func NewClient(config EtcdConfig) (*EtcdRepository, error) {
+	// A zero timeout would fail every request instead of disabling the timeout.
+	if config.DialTimeout <= 0 {
+		return nil, fmt.Errorf("Etcd dial timeout must be positive, got %v!", config.DialTimeout)
+	} else if config.RequestTimeout <= 0 {
+		return nil, fmt.Errorf("Etcd request timeout must be positive, got %v!", config.RequestTimeout)
+	}
+	cli, err := newEtcdClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &EtcdRepository{
+		client:   cli,
+		config:   config,
+		watchers: make(map[*clientv3.Client]int),
+		replaced: make(map[*clientv3.Client]bool),
+	}, nil
+}
+
+func newEtcdClient(config EtcdConfig) (*clientv3.Client, error) {
+	clientConfig := clientv3.Config{
+		Endpoints:            config.Endpoints,
+		DialTimeout:          config.DialTimeout,
+		DialKeepAliveTime:    keepAliveTime,
+		DialKeepAliveTimeout: config.DialTimeout,
+		Username:             config.Username,
+		Password:             config.Password,
+	}
+	if config.CertFile != "" || config.KeyFile != "" || config.CAFile != "" {
+		tlsInfo := transport.TLSInfo{
+			CertFile:      config.CertFile,
+			KeyFile:       config.KeyFile,
+			TrustedCAFile: config.CAFile,
+		}
+		tlsConfig, err := tlsInfo.ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		clientConfig.TLS = tlsConfig
+	}
+	cli, err := clientv3.New(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	if config.KeyPrefix != "" {
+		cli.KV = namespace.NewKV(cli.KV, config.KeyPrefix)
+		cli.Watcher = namespace.NewWatcher(cli.Watcher, config.KeyPrefix)
+		cli.Lease = namespace.NewLease(cli.Lease, config.KeyPrefix)
+	}
+	return cli, nil
+}
+
+func (repo *EtcdRepository) getClient() *clientv3.Client {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	return repo.client
+}
+
+func (repo *EtcdRepository) Close() {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	for cli := range repo.replaced {
+		cli.Close()
+	}
+	repo.replaced = make(map[*clientv3.Client]bool)
+	repo.client.Close()
+}
+
+// CheckHealth performs a read against etcd and replaces the shared client
+// with a freshly dialed one once reconnectAfterFailures reads in a row failed.
+func (repo *EtcdRepository) CheckHealth() error {
+	ctx, cancel := context.WithTimeout(context.Background(), repo.config.RequestTimeout)
+	defer cancel()
+	_, err := repo.getClient().Get(ctx, "health")
+	repo.mu.Lock()
+	if err == nil {
+		repo.failures = 0
+	} else {
+		repo.failures++
+	}
+	failures := repo.failures
+	repo.mu.Unlock()
+	if failures < reconnectAfterFailures {
+		return err
+	}
+	if reconnectErr := repo.reconnect(); reconnectErr != nil {
+		return reconnectErr
+	}
+	return err
+}
+
+// reconnect replaces the shared client. The old client is closed right away
+// unless watches still run on it.
+func (repo *EtcdRepository) reconnect() error {
+	cli, err := newEtcdClient(repo.config)
+	if err != nil {
+		return err
+	}
+	repo.mu.Lock()
+	old := repo.client
+	repo.client = cli
+	repo.failures = 0
+	if repo.watchers[old] > 0 {
+		repo.replaced[old] = true
+		old = nil
+	}
+	repo.mu.Unlock()
+	if old != nil {
+		old.Close()
+	}
+	return nil
+}
+
+// acquireWatchClient returns the shared client, which is kept open until the
+// watch calls releaseWatchClient.
+func (repo *EtcdRepository) acquireWatchClient() *clientv3.Client {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	repo.watchers[repo.client]++
+	return repo.client
+}
+
+func (repo *EtcdRepository) releaseWatchClient(cli *clientv3.Client) {
+	repo.mu.Lock()
+	repo.watchers[cli]--
+	closeClient := repo.watchers[cli] == 0 && repo.replaced[cli]
+	if repo.watchers[cli] == 0 {
+		delete(repo.watchers, cli)
+		delete(repo.replaced, cli)
+	}
+	repo.mu.Unlock()
+	if closeClient {
+		cli.Close()
+	}
+}
+
+func (repo *EtcdRepository) SaveConfigSchema(ctx context.Context, key string, user *pb.User, schema string, state pb.LifecycleState) error {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	serializedData, err := encodeSchemaData(user, schema, state)
+	if err != nil {
+		return err
+	}
+	referencedKeys, err := findReferences(key, schema)
+	if err != nil {
+		return err
+	}
+	latestKey := getLatestVersionKey(key)
+	for {
+		latestVersion, latestRevision, err := repo.getLatestVersion(ctx, key)
+		if err != nil {
+			return err
+		}
+		res, err := repo.getClient().Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		var existing []byte
+		var existingRevision int64
+		if len(res.Kvs) > 0 {
+			existing, existingRevision = res.Kvs[0].Value, res.Kvs[0].ModRevision
+		}
+		if err := checkSaveAllowed(key, latestVersion, existing); err != nil {
+			return err
+		}
+		staleKeys, err := staleReferences(existing, referencedKeys)
+		if err != nil {
+			return err
+		}
+		// The mod revision of a missing key compares as 0, so the transaction
+		// fails if the key has been saved or a draft has been replaced since.
+		conditions := []clientv3.Cmp{
+			clientv3.Compare(clientv3.ModRevision(latestKey), "=", latestRevision),
+			clientv3.Compare(clientv3.ModRevision(key), "=", existingRevision),
+		}
+		thenOps := []clientv3.Op{
+			clientv3.OpPut(key, string(serializedData)),
+			clientv3.OpPut(latestKey, getSchemaDetailsFromKey(key).GetVersion()),
+		}
+		for _, referenced := range staleKeys {
+			thenOps = append(thenOps, clientv3.OpDelete(getReferenceKey(referenced, key)))
+		}
+		// Referenced schemas must stay as they were checked, so they are
+		// neither deleted nor replaced as a draft in the meantime.
+		for _, referenced := range referencedKeys {
+			res, err := repo.getClient().Get(ctx, referenced)
+			if err != nil {
+				return err
+			}
+			var value []byte
+			var revision int64
+			if len(res.Kvs) > 0 {
+				value, revision = res.Kvs[0].Value, res.Kvs[0].ModRevision
+			}
+			if err := checkReference(referenced, value); err != nil {
+				return err
+			}
+			conditions = append(conditions, clientv3.Compare(clientv3.ModRevision(referenced), "=", revision))
+			thenOps = append(thenOps, clientv3.OpPut(getReferenceKey(referenced, key), ""))
+		}
+		txnRes, err := repo.getClient().Txn(ctx).If(conditions...).Then(thenOps...).Commit()
+		if err != nil {
+			return err
+		}
+		if txnRes.Succeeded {
+			return nil
+		}
+	}
+}
+
+func (repo *EtcdRepository) GetConfigSchema(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	value, err := repo.getValue(ctx, key)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return decodeSchemaData(value)
+}
+
+func (repo *EtcdRepository) GetConfigSchemaMetadata(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	value, err := repo.getValue(ctx, key)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return decodeSchemaMetadata(value)
+}
+
+func (repo *EtcdRepository) getValue(ctx context.Context, key string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	resp, err := repo.getClient().Get(ctx, key)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	return resp.Kvs[0].Value, nil
+}
+
+func (repo *EtcdRepository) DeleteConfigSchema(ctx context.Context, key string, user *pb.User) error {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	referencesPrefix := getReferencesPrefix(key)
+	for {
+		res, err := repo.getClient().Get(ctx, key)
+		if err != nil {
+			return err
+		} else if len(res.Kvs) == 0 {
+			return &SchemaNotFoundError{Key: key}
+		}
+		referencedKeys, err := getStoredReferences(res.Kvs[0].Value)
+		if err != nil {
+			return err
+		}
+		tombstone, err := encodeTombstone(res.Kvs[0].Value, user)
+		if err != nil {
+			return err
+		}
+		// The latest version pointer is left as it is, so the deleted version
+		// stays reserved.
+		thenOps := []clientv3.Op{clientv3.OpDelete(key), clientv3.OpPut(getTombstoneKey(key), string(tombstone))}
+		for _, referenced := range referencedKeys {
+			thenOps = append(thenOps, clientv3.OpDelete(getReferenceKey(referenced, key)))
+		}
+		txnRes, err := repo.getClient().Txn(ctx).
+			If(
+				clientv3.Compare(clientv3.ModRevision(key), "=", res.Kvs[0].ModRevision),
+				clientv3.Compare(clientv3.CreateRevision(referencesPrefix), "=", 0).WithPrefix(),
+			).
+			Then(thenOps...).
+			Else(clientv3.OpGet(referencesPrefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())).
+			Commit()
+		if err != nil {
+			return err
+		}
+		if txnRes.Succeeded {
+			return nil
+		}
+		// The schema changed in the meantime unless it is still referenced.
+		kvs := txnRes.Responses[0].GetResponseRange().GetKvs()
+		if len(kvs) == 0 {
+			continue
+		}
+		referencedBy := make([]string, len(kvs))
+		for i, kv := range kvs {
+			referencedBy[i] = strings.TrimPrefix(string(kv.Key), referencesPrefix)
+		}
+		return &SchemaReferencedError{Key: key, ReferencedBy: referencedBy}
+	}
+}
+
+func (repo *EtcdRepository) RestoreConfigSchema(ctx context.Context, key string, deletedAfter time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	tombstoneKey := getTombstoneKey(key)
+	for {
+		res, err := repo.getClient().Get(ctx, tombstoneKey)
+		if err != nil {
+			return err
+		} else if len(res.Kvs) == 0 {
+			return &TombstoneNotFoundError{Key: key}
+		}
+		value, err := restoreTombstone(key, res.Kvs[0].Value, deletedAfter)
+		if err != nil {
+			return err
+		}
+		referencedKeys, err := getStoredReferences(value)
+		if err != nil {
+			return err
+		}
+		conditions := []clientv3.Cmp{clientv3.Compare(clientv3.ModRevision(tombstoneKey), "=", res.Kvs[0].ModRevision)}
+		thenOps := []clientv3.Op{clientv3.OpPut(key, string(value)), clientv3.OpDelete(tombstoneKey)}
+		var elseOps []clientv3.Op
+		for _, referenced := range referencedKeys {
+			conditions = append(conditions, clientv3.Compare(clientv3.CreateRevision(referenced), "!=", 0))
+			thenOps = append(thenOps, clientv3.OpPut(getReferenceKey(referenced, key), ""))
+			elseOps = append(elseOps, clientv3.OpGet(referenced, clientv3.WithCountOnly()))
+		}
+		txnRes, err := repo.getClient().Txn(ctx).If(conditions...).Then(thenOps...).Else(elseOps...).Commit()
+		if err != nil {
+			return err
+		}
+		if txnRes.Succeeded {
+			return nil
+		}
+		for i, referenced := range referencedKeys {
+			if txnRes.Responses[i].GetResponseRange().GetCount() == 0 {
+				return &ReferenceNotFoundError{Key: referenced}
+			}
+		}
+	}
+}
+
+func (repo *EtcdRepository) PurgeConfigSchema(ctx context.Context, key string) error {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	tombstoneKey := getTombstoneKey(key)
+	for {
+		res, err := repo.getClient().Get(ctx, tombstoneKey)
+		if err != nil {
+			return err
+		} else if len(res.Kvs) == 0 {
+			return &TombstoneNotFoundError{Key: key}
+		}
+		purged, err := purgeTombstone(res.Kvs[0].Value)
+		if err != nil {
+			return err
+		}
+		txnRes, err := repo.getClient().Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(tombstoneKey), "=", res.Kvs[0].ModRevision)).
+			Then(clientv3.OpPut(tombstoneKey, string(purged))).
+			Commit()
+		if err != nil {
+			return err
+		} else if txnRes.Succeeded {
+			return nil
+		}
+	}
+}
+
+// getLatestVersion returns the latest version of the schema that key belongs
+// to along with the mod revision of its pointer key, which is 0 when the
+// pointer has not been written yet.
+func (repo *EtcdRepository) getLatestVersion(ctx context.Context, key string) (string, int64, error) {
+	res, err := repo.getClient().Get(ctx, getLatestVersionKey(key))
+	if err != nil {
+		return "", 0, err
+	}
+	if len(res.Kvs) > 0 {
+		return string(res.Kvs[0].Value), res.Kvs[0].ModRevision, nil
+	}
+	versions, err := repo.getVersions(ctx, getSchemaPrefixFromKey(key))
+	if err != nil {
+		return "", 0, err
+	}
+	return maxVersion(versions), 0, nil
+}
+
+// getVersions returns the versions saved under prefix, including deleted
+// ones.
+func (repo *EtcdRepository) getVersions(ctx context.Context, prefix string) ([]string, error) {
+	var versions []string
+	for _, scanned := range []string{prefix, getTombstonesPrefix(prefix)} {
+		res, err := repo.getClient().Get(ctx, scanned, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range res.Kvs {
+			versions = append(versions, getSchemaDetailsFromKey(getKeyFromTombstoneKey(string(kv.Key))).GetVersion())
+		}
+	}
+	return versions, nil
+}
+
+func (repo *EtcdRepository) GetSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	return repo.getSchemasByPrefix(ctx, prefix, decodeSchemaData)
+}
+
+func (repo *EtcdRepository) GetSchemaMetadataByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	return repo.getSchemasByPrefix(ctx, prefix, decodeSchemaMetadata)
+}
+
+func (repo *EtcdRepository) getSchemasByPrefix(ctx context.Context, prefix string, decode func([]byte) (*pb.ConfigSchemaData, error)) ([]*pb.ConfigSchema, error) {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	res, err := repo.getClient().Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	} else if res.Count == 0 {
+		return nil, nil
+	}
+	schemas := make([]*pb.ConfigSchema, res.Count)
+	for i, schemaKv := range res.Kvs {
+		schemaData, err := decode(schemaKv.Value)
+		if err != nil {
+			return nil, err
+		}
+		schemas[i] = &pb.ConfigSchema{
+			SchemaDetails: getSchemaDetailsFromKey(string(schemaKv.Key)),
+			SchemaData:    schemaData,
+		}
+	}
+	sortSchemasByVersion(schemas)
+	return schemas, nil
+}
+
+func (repo *EtcdRepository) GetDeletedSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	res, err := repo.getClient().Get(ctx, getTombstonesPrefix(prefix), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	schemas := make([]*pb.ConfigSchema, 0, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		schema, err := decodeTombstone(string(kv.Key), kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	sortSchemasByVersion(schemas)
+	return schemas, nil
+}
+
+func (repo *EtcdRepository) GetLatestVersionByPrefix(ctx context.Context, prefix string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	versions, err := repo.getVersions(ctx, prefix)
+	if err != nil {
+		return "", err
+	}
+	return maxVersion(versions), nil
+}
+
+func (repo *EtcdRepository) GetSchemaDetailsByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchemaDetails, error) {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	ops := []clientv3.Op{clientv3.OpGet(prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())}
+	if prefix == "" {
+		// Bookkeeping keys all start with '/', which schema keys never do, so
+		// only the keys sorting below and above them are read.
+		ops = []clientv3.Op{
+			clientv3.OpGet("\x00", clientv3.WithRange("/"), clientv3.WithKeysOnly()),
+			clientv3.OpGet("0", clientv3.WithFromKey(), clientv3.WithKeysOnly()),
+		}
+	}
+	res, err := repo.getClient().Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		return nil, err
+	}
+	var schemaDetails []*pb.ConfigSchemaDetails
+	for _, opRes := range res.Responses {
+		for _, kv := range opRes.GetResponseRange().GetKvs() {
+			if isSchemaKey(string(kv.Key)) {
+				schemaDetails = append(schemaDetails, getSchemaDetailsFromKey(string(kv.Key)))
+			}
+		}
+	}
+	sortSchemaDetails(schemaDetails)
+	return schemaDetails, nil
+}
+
+func (repo *EtcdRepository) SetCompatibilityMode(ctx context.Context, prefix string, mode pb.CompatibilityMode) error {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	var err error
+	if mode == pb.CompatibilityMode_COMPATIBILITY_MODE_UNSPECIFIED {
+		_, err = repo.getClient().Delete(ctx, getCompatibilityModeKey(prefix))
+	} else {
+		_, err = repo.getClient().Put(ctx, getCompatibilityModeKey(prefix), mode.String())
+	}
+	return err
+}
+
+func (repo *EtcdRepository) GetCompatibilityMode(ctx context.Context, prefix string) (pb.CompatibilityMode, error) {
+	value, err := repo.getValue(ctx, getCompatibilityModeKey(prefix))
+	if err != nil {
+		return pb.CompatibilityMode_COMPATIBILITY_MODE_UNSPECIFIED, err
+	}
+	return decodeCompatibilityMode(value), nil
+}
+
+func (repo *EtcdRepository) SetRoleBinding(ctx context.Context, binding *pb.RoleBinding) error {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	var err error
+	if binding.GetRole() == pb.Role_ROLE_UNSPECIFIED {
+		_, err = repo.getClient().Delete(ctx, getRoleBindingKey(binding))
+	} else {
+		_, err = repo.getClient().Put(ctx, getRoleBindingKey(binding), binding.GetRole().String())
+	}
+	return err
+}
+
+func (repo *EtcdRepository) ListRoleBindings(ctx context.Context, username string) ([]*pb.RoleBinding, error) {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	res, err := repo.getClient().Get(ctx, getRoleBindingsPrefix(username), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	bindings := make([]*pb.RoleBinding, 0, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		bindings = append(bindings, decodeRoleBinding(string(kv.Key), kv.Value))
+	}
+	sortRoleBindings(bindings)
+	return bindings, nil
+}
+
+func (repo *EtcdRepository) AppendAuditEvent(ctx context.Context, event *pb.AuditEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	event.Id = newAuditEventID(event.GetTime().AsTime())
+	value, err := encodeAuditEvent(event)
+	if err != nil {
+		return err
+	}
+	key := getAuditEventKey(event.GetId())
+	res, err := repo.getClient().Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return err
+	} else if !res.Succeeded {
+		return auditEventExistsError(event.GetId())
+	}
+	return nil
+}
+
+// auditBatchSize limits how many audit events are read from etcd at once
+// while looking for events matching a filter.
+const auditBatchSize = 500
+
+func (repo *EtcdRepository) ListAuditEvents(ctx context.Context, filter AuditFilter) ([]*pb.AuditEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	start, end := getAuditRange(filter)
+	var events []*pb.AuditEvent
+	for {
+		res, err := repo.getClient().Get(ctx, start, clientv3.WithRange(end), clientv3.WithLimit(auditBatchSize))
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range res.Kvs {
+			event, err := decodeAuditEvent(kv.Value)
+			if err != nil {
+				return nil, err
+			}
+			if filter.matches(event) {
+				events = append(events, event)
+				if len(events) == filter.Limit {
+					return events, nil
+				}
+			}
+		}
+		if !res.More || len(res.Kvs) == 0 {
+			return events, nil
+		}
+		start = string(res.Kvs[len(res.Kvs)-1].Key) + "\x00"
+	}
+}
+
+func (repo *EtcdRepository) SetLifecycleState(ctx context.Context, key string, state pb.LifecycleState, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	for {
+		res, err := repo.getClient().Get(ctx, key)
+		if err != nil {
+			return err
+		} else if len(res.Kvs) == 0 {
+			return &SchemaNotFoundError{Key: key}
+		}
+		transitioned, err := transitionSchemaData(key, res.Kvs[0].Value, state, reason)
+		if err != nil {
+			return err
+		}
+		txnRes, err := repo.getClient().Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", res.Kvs[0].ModRevision)).
+			Then(clientv3.OpPut(key, string(transitioned))).
+			Commit()
+		if err != nil {
+			return err
+		} else if txnRes.Succeeded {
+			return nil
+		}
+	}
+}
+
+// getDeletedBy returns the user who deleted key at revision, which is kept by
+// the tombstone written in the same transaction.
+func (repo *EtcdRepository) getDeletedBy(ctx context.Context, key string, revision int64) (*pb.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, repo.config.RequestTimeout)
+	defer cancel()
+	res, err := repo.getClient().Get(ctx, getTombstoneKey(key), clientv3.WithRev(revision))
+	if err != nil {
+		return nil, err
+	} else if len(res.Kvs) == 0 {
+		return nil, nil
+	}
+	schemaData, err := decodeSchemaMetadata(res.Kvs[0].Value)
+	if err != nil {
+		return nil, err
+	}
+	return schemaData.GetDeletedBy(), nil
+}
+
+func (repo *EtcdRepository) WatchSchemas(ctx context.Context, prefix string, startRevision int64, handle func(*pb.SchemaEvent) error) error {
+	ctx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+	defer cancel()
+	opts := []clientv3.OpOption{clientv3.WithPrefix()}
+	if startRevision > 0 {
+		opts = append(opts, clientv3.WithRev(startRevision))
+	}
+	cli := repo.acquireWatchClient()
+	defer repo.releaseWatchClient(cli)
+	for res := range cli.Watch(ctx, prefix, opts...) {
+		if res.CompactRevision != 0 {
+			return &RevisionCompactedError{CompactRevision: res.CompactRevision}
+		} else if err := res.Err(); err != nil {
+			return err
+		}
+		for _, ev := range res.Events {
+			key := string(ev.Kv.Key)
+			if !isSchemaKey(key) {
+				continue
+			}
+			event := &pb.SchemaEvent{
+				Type:          pb.SchemaEventType_DELETED,
+				SchemaDetails: getSchemaDetailsFromKey(key),
+				Revision:      ev.Kv.ModRevision,
+			}
+			if ev.Type == clientv3.EventTypePut {
+				schemaData, err := decodeSchemaMetadata(ev.Kv.Value)
+				if err != nil {
+					return err
+				}
+				event.Type = pb.SchemaEventType_CREATED
+				if !ev.IsCreate() {
+					event.Type = pb.SchemaEventType_UPDATED
+				}
+				event.User = schemaData.GetUser()
+				event.CreationTime = schemaData.GetCreationTime()
+				event.State = schemaData.GetState()
+			} else {
+				deletedBy, err := repo.getDeletedBy(ctx, key, ev.Kv.ModRevision)
+				if err != nil {
+					return err
+				}
+				event.User = deletedBy
+			}
+			if err := handle(event); err != nil {
+				return err
+			}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return ErrWatchClosed
+}
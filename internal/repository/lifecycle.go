@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"encoding/json"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+// allowedTransitions maps every lifecycle state onto the states a schema in
+// it can be moved to. Retired schemas cannot be moved anymore.
+var allowedTransitions = map[pb.LifecycleState][]pb.LifecycleState{
+	pb.LifecycleState_DRAFT:      {pb.LifecycleState_PUBLISHED, pb.LifecycleState_RETIRED},
+	pb.LifecycleState_PUBLISHED:  {pb.LifecycleState_DEPRECATED, pb.LifecycleState_RETIRED},
+	pb.LifecycleState_DEPRECATED: {pb.LifecycleState_PUBLISHED, pb.LifecycleState_RETIRED},
+}
+
+type InvalidTransitionError struct {
+	Key  string
+	From pb.LifecycleState
+	To   pb.LifecycleState
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return "Schema with key '" + e.Key + "' cannot be moved from " + e.From.String() + " to " + e.To.String() + "!"
+}
+
+// Schemas saved before lifecycle states were introduced are published.
+func normalizeState(schemaData *pb.ConfigSchemaData) {
+	if schemaData.GetState() == pb.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED {
+		schemaData.State = pb.LifecycleState_PUBLISHED
+	}
+}
+
+func canTransition(from pb.LifecycleState, to pb.LifecycleState) bool {
+	if from == to {
+		return true
+	}
+	for _, allowed := range allowedTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// transitionSchemaData moves stored schema data to state. Setting the current
+// state again only replaces the reason.
+func transitionSchemaData(key string, value []byte, state pb.LifecycleState, reason string) ([]byte, error) {
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(value, &schemaData); err != nil {
+		return nil, err
+	}
+	normalizeState(&schemaData)
+	if !canTransition(schemaData.GetState(), state) {
+		return nil, &InvalidTransitionError{Key: key, From: schemaData.GetState(), To: state}
+	}
+	schemaData.State = state
+	schemaData.StateReason = reason
+	return json.Marshal(&schemaData)
+}
+
+// checkSaveAllowed checks that key can be saved, given the latest version
+// saved under its prefix and the value stored under key, if any. Drafts can
+// be overwritten as long as no higher version has been saved.
+func checkSaveAllowed(key string, latestVersion string, existing []byte) error {
+	if existing != nil && isDraft(existing) && getSchemaDetailsFromKey(key).GetVersion() == latestVersion {
+		return nil
+	}
+	if err := checkVersionIsLatest(key, latestVersion); err != nil {
+		return err
+	}
+	if existing != nil {
+		return &SchemaExistsError{Key: key}
+	}
+	return nil
+}
+
+// checkReference checks that the value stored under a referenced key can be
+// referenced, i.e. that it exists and is not a draft.
+func checkReference(referenced string, value []byte) error {
+	if value == nil {
+		return &ReferenceNotFoundError{Key: referenced}
+	} else if isDraft(value) {
+		return &DraftReferenceError{Key: referenced}
+	}
+	return nil
+}
+
+func isDraft(value []byte) bool {
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(value, &schemaData); err != nil {
+		return false
+	}
+	return schemaData.GetState() == pb.LifecycleState_DRAFT
+}
+
+// staleReferences returns the keys referenced by a replaced draft which its
+// replacement no longer references.
+func staleReferences(existing []byte, referencedKeys []string) ([]string, error) {
+	if existing == nil {
+		return nil, nil
+	}
+	previous, err := getStoredReferences(existing)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]bool, len(referencedKeys))
+	for _, referenced := range referencedKeys {
+		current[referenced] = true
+	}
+	var stale []string
+	for _, referenced := range previous {
+		if !current[referenced] {
+			stale = append(stale, referenced)
+		}
+	}
+	return stale, nil
+}
@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+var _ SchemaRepository = (*MemoryRepository)(nil)
+
+type MemoryRepository struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		data: make(map[string][]byte),
+	}
+}
+
+func (repo *MemoryRepository) Close() {}
+
+func (repo *MemoryRepository) SaveConfigSchema(ctx context.Context, key string, user *pb.User, schema string, state pb.LifecycleState) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	existing := repo.data[key]
+	if err := checkSaveAllowed(key, maxVersion(repo.getVersions(getSchemaPrefixFromKey(key))), existing); err != nil {
+		return err
+	}
+	referencedKeys, err := findReferences(key, schema)
+	if err != nil {
+		return err
+	}
+	for _, referenced := range referencedKeys {
+		if err := checkReference(referenced, repo.data[referenced]); err != nil {
+			return err
+		}
+	}
+	staleKeys, err := staleReferences(existing, referencedKeys)
+	if err != nil {
+		return err
+	}
+	serializedData, err := encodeSchemaData(user, schema, state)
+	if err != nil {
+		return err
+	}
+	repo.data[key] = serializedData
+	for _, referenced := range staleKeys {
+		delete(repo.data, getReferenceKey(referenced, key))
+	}
+	for _, referenced := range referencedKeys {
+		repo.data[getReferenceKey(referenced, key)] = nil
+	}
+	return nil
+}
+
+func (repo *MemoryRepository) GetConfigSchema(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	repo.mu.RLock()
+	value, ok := repo.data[key]
+	repo.mu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+	return decodeSchemaData(value)
+}
+
+func (repo *MemoryRepository) GetConfigSchemaMetadata(ctx context.Context, key string) (*pb.ConfigSchemaData, error) {
+	repo.mu.RLock()
+	value, ok := repo.data[key]
+	repo.mu.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+	return decodeSchemaMetadata(value)
+}
+
+func (repo *MemoryRepository) DeleteConfigSchema(ctx context.Context, key string, user *pb.User) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	value, ok := repo.data[key]
+	if !ok {
+		return &SchemaNotFoundError{Key: key}
+	}
+	if referencedBy := repo.getReferrers(key); len(referencedBy) > 0 {
+		return &SchemaReferencedError{Key: key, ReferencedBy: referencedBy}
+	}
+	referencedKeys, err := getStoredReferences(value)
+	if err != nil {
+		return err
+	}
+	tombstone, err := encodeTombstone(value, user)
+	if err != nil {
+		return err
+	}
+	delete(repo.data, key)
+	for _, referenced := range referencedKeys {
+		delete(repo.data, getReferenceKey(referenced, key))
+	}
+	repo.data[getTombstoneKey(key)] = tombstone
+	return nil
+}
+
+func (repo *MemoryRepository) RestoreConfigSchema(ctx context.Context, key string, deletedAfter time.Time) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	tombstone, ok := repo.data[getTombstoneKey(key)]
+	if !ok {
+		return &TombstoneNotFoundError{Key: key}
+	}
+	value, err := restoreTombstone(key, tombstone, deletedAfter)
+	if err != nil {
+		return err
+	}
+	referencedKeys, err := getStoredReferences(value)
+	if err != nil {
+		return err
+	}
+	for _, referenced := range referencedKeys {
+		if _, ok := repo.data[referenced]; !ok {
+			return &ReferenceNotFoundError{Key: referenced}
+		}
+	}
+	delete(repo.data, getTombstoneKey(key))
+	repo.data[key] = value
+	for _, referenced := range referencedKeys {
+		repo.data[getReferenceKey(referenced, key)] = nil
+	}
+	return nil
+}
+
+func (repo *MemoryRepository) PurgeConfigSchema(ctx context.Context, key string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	tombstone, ok := repo.data[getTombstoneKey(key)]
+	if !ok {
+		return &TombstoneNotFoundError{Key: key}
+	}
+	purged, err := purgeTombstone(tombstone)
+	if err != nil {
+		return err
+	}
+	repo.data[getTombstoneKey(key)] = purged
+	return nil
+}
+
+func (repo *MemoryRepository) getReferrers(key string) []string {
+	var referrers []string
+	for k := range repo.data {
+		if strings.HasPrefix(k, getReferencesPrefix(key)) {
+			referrers = append(referrers, strings.TrimPrefix(k, getReferencesPrefix(key)))
+		}
+	}
+	sort.Strings(referrers)
+	return referrers
+}
+
+func (repo *MemoryRepository) GetSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	return repo.getSchemasByPrefix(ctx, prefix, decodeSchemaData)
+}
+
+func (repo *MemoryRepository) GetSchemaMetadataByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	return repo.getSchemasByPrefix(ctx, prefix, decodeSchemaMetadata)
+}
+
+func (repo *MemoryRepository) getSchemasByPrefix(ctx context.Context, prefix string, decode func([]byte) (*pb.ConfigSchemaData, error)) ([]*pb.ConfigSchema, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	var schemas []*pb.ConfigSchema
+	for key, value := range repo.data {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		schemaData, err := decode(value)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, &pb.ConfigSchema{
+			SchemaDetails: getSchemaDetailsFromKey(key),
+			SchemaData:    schemaData,
+		})
+	}
+	sortSchemasByVersion(schemas)
+	return schemas, nil
+}
+
+func (repo *MemoryRepository) GetDeletedSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	var schemas []*pb.ConfigSchema
+	for key, value := range repo.data {
+		if !strings.HasPrefix(key, getTombstonesPrefix(prefix)) {
+			continue
+		}
+		schema, err := decodeTombstone(key, value)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	sortSchemasByVersion(schemas)
+	return schemas, nil
+}
+
+func (repo *MemoryRepository) GetLatestVersionByPrefix(ctx context.Context, prefix string) (string, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	return maxVersion(repo.getVersions(prefix)), nil
+}
+
+func (repo *MemoryRepository) GetSchemaDetailsByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchemaDetails, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	var schemaDetails []*pb.ConfigSchemaDetails
+	for key := range repo.data {
+		if strings.HasPrefix(key, prefix) && isSchemaKey(key) {
+			schemaDetails = append(schemaDetails, getSchemaDetailsFromKey(key))
+		}
+	}
+	sortSchemaDetails(schemaDetails)
+	return schemaDetails, nil
+}
+
+func (repo *MemoryRepository) SetCompatibilityMode(ctx context.Context, prefix string, mode pb.CompatibilityMode) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if mode == pb.CompatibilityMode_COMPATIBILITY_MODE_UNSPECIFIED {
+		delete(repo.data, getCompatibilityModeKey(prefix))
+	} else {
+		repo.data[getCompatibilityModeKey(prefix)] = []byte(mode.String())
+	}
+	return nil
+}
+
+func (repo *MemoryRepository) GetCompatibilityMode(ctx context.Context, prefix string) (pb.CompatibilityMode, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	return decodeCompatibilityMode(repo.data[getCompatibilityModeKey(prefix)]), nil
+}
+
+func (repo *MemoryRepository) SetRoleBinding(ctx context.Context, binding *pb.RoleBinding) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if binding.GetRole() == pb.Role_ROLE_UNSPECIFIED {
+		delete(repo.data, getRoleBindingKey(binding))
+	} else {
+		repo.data[getRoleBindingKey(binding)] = []byte(binding.GetRole().String())
+	}
+	return nil
+}
+
+func (repo *MemoryRepository) ListRoleBindings(ctx context.Context, username string) ([]*pb.RoleBinding, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	prefix := getRoleBindingsPrefix(username)
+	var bindings []*pb.RoleBinding
+	for key, value := range repo.data {
+		if strings.HasPrefix(key, prefix) {
+			bindings = append(bindings, decodeRoleBinding(key, value))
+		}
+	}
+	sortRoleBindings(bindings)
+	return bindings, nil
+}
+
+func (repo *MemoryRepository) AppendAuditEvent(ctx context.Context, event *pb.AuditEvent) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	event.Id = newAuditEventID(event.GetTime().AsTime())
+	value, err := encodeAuditEvent(event)
+	if err != nil {
+		return err
+	}
+	key := getAuditEventKey(event.GetId())
+	if _, ok := repo.data[key]; ok {
+		return auditEventExistsError(event.GetId())
+	}
+	repo.data[key] = value
+	return nil
+}
+
+func (repo *MemoryRepository) ListAuditEvents(ctx context.Context, filter AuditFilter) ([]*pb.AuditEvent, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	start, end := getAuditRange(filter)
+	var keys []string
+	for key := range repo.data {
+		if key >= start && key < end {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var events []*pb.AuditEvent
+	for _, key := range keys {
+		event, err := decodeAuditEvent(repo.data[key])
+		if err != nil {
+			return nil, err
+		}
+		if filter.matches(event) {
+			events = append(events, event)
+			if len(events) == filter.Limit {
+				break
+			}
+		}
+	}
+	return events, nil
+}
+
+func (repo *MemoryRepository) SetLifecycleState(ctx context.Context, key string, state pb.LifecycleState, reason string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	value, ok := repo.data[key]
+	if !ok {
+		return &SchemaNotFoundError{Key: key}
+	}
+	transitioned, err := transitionSchemaData(key, value, state, reason)
+	if err != nil {
+		return err
+	}
+	repo.data[key] = transitioned
+	return nil
+}
+
+// getVersions returns the versions saved under prefix, including deleted
+// ones.
+func (repo *MemoryRepository) getVersions(prefix string) []string {
+	var versions []string
+	for key := range repo.data {
+		if strings.HasPrefix(key, prefix) {
+			versions = append(versions, getSchemaDetailsFromKey(key).GetVersion())
+		} else if strings.HasPrefix(key, getTombstonesPrefix(prefix)) {
+			versions = append(versions, getSchemaDetailsFromKey(getKeyFromTombstoneKey(key)).GetVersion())
+		}
+	}
+	return versions
+}
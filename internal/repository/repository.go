@@ -5,149 +5,406 @@ import (
 	"encoding/json"
 	"errors"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/jtomic1/config-schema-service/internal/references"
 	pb "github.com/jtomic1/config-schema-service/proto"
-	clientv3 "go.etcd.io/etcd/client/v3"
 	"golang.org/x/mod/semver"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"sigs.k8s.io/yaml"
 )
 
-var (
-	endpoint = "localhost:2379"
-	timeout  = 5 * time.Second
-)
+type SchemaRepository interface {
+	// SaveConfigSchema saves a schema in the DRAFT or PUBLISHED state. A draft
+	// stored under key is overwritten if key holds the latest version.
+	SaveConfigSchema(ctx context.Context, key string, user *pb.User, schema string, state pb.LifecycleState) error
+	GetConfigSchema(ctx context.Context, key string) (*pb.ConfigSchemaData, error)
+	GetConfigSchemaMetadata(ctx context.Context, key string) (*pb.ConfigSchemaData, error)
+	// DeleteConfigSchema replaces the schema with a tombstone, which keeps its
+	// version reserved and allows restoring it.
+	DeleteConfigSchema(ctx context.Context, key string, user *pb.User) error
+	// RestoreConfigSchema restores a schema deleted at or after deletedAfter.
+	RestoreConfigSchema(ctx context.Context, key string, deletedAfter time.Time) error
+	// PurgeConfigSchema removes the schema kept by a tombstone for good. The
+	// tombstone itself is kept, so the version stays reserved.
+	PurgeConfigSchema(ctx context.Context, key string) error
+	GetSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error)
+	// GetSchemaMetadataByPrefix is GetSchemasByPrefix without the schemas
+	// themselves, which are left empty.
+	GetSchemaMetadataByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error)
+	GetDeletedSchemasByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchema, error)
+	// GetLatestVersionByPrefix returns the highest version saved under prefix,
+	// including deleted versions.
+	GetLatestVersionByPrefix(ctx context.Context, prefix string) (string, error)
+	GetSchemaDetailsByPrefix(ctx context.Context, prefix string) ([]*pb.ConfigSchemaDetails, error)
+	SetCompatibilityMode(ctx context.Context, prefix string, mode pb.CompatibilityMode) error
+	GetCompatibilityMode(ctx context.Context, prefix string) (pb.CompatibilityMode, error)
+	SetRoleBinding(ctx context.Context, binding *pb.RoleBinding) error
+	ListRoleBindings(ctx context.Context, username string) ([]*pb.RoleBinding, error)
+	// AppendAuditEvent stores the event under a new ID, which it assigns to
+	// event.Id. Stored events are never modified or deleted.
+	AppendAuditEvent(ctx context.Context, event *pb.AuditEvent) error
+	ListAuditEvents(ctx context.Context, filter AuditFilter) ([]*pb.AuditEvent, error)
+	SetLifecycleState(ctx context.Context, key string, state pb.LifecycleState, reason string) error
+	Close()
+}
 
-type EtcdRepository struct {
-	client *clientv3.Client
+type HealthChecker interface {
+	CheckHealth() error
 }
 
-func NewClient() (*EtcdRepository, error) {
-	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{endpoint},
-		DialTimeout: timeout,
-	})
-	return &EtcdRepository{
-		client: cli,
-	}, err
+// SchemaWatcher is implemented by repositories which can stream changes to
+// the schemas under a prefix. WatchSchemas calls handle for every event at or
+// after startRevision (or from now on if it is 0) until ctx is done or handle
+// returns an error.
+type SchemaWatcher interface {
+	WatchSchemas(ctx context.Context, prefix string, startRevision int64, handle func(*pb.SchemaEvent) error) error
 }
 
-func (repo *EtcdRepository) Close() {
-	repo.client.Close()
+var ErrWatchClosed = errors.New("Watch was closed by the storage backend!")
+
+type SchemaExistsError struct {
+	Key string
 }
 
-func (repo *EtcdRepository) SaveConfigSchema(key string, user *pb.User, schema string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	res, err := repo.client.Get(ctx, key)
-	if err != nil {
-		return err
-	}
-	if res.Count > 0 {
-		return errors.New("Key '" + key + "' already exists!")
+func (e *SchemaExistsError) Error() string {
+	return "Key '" + e.Key + "' already exists!"
+}
+
+type SchemaNotFoundError struct {
+	Key string
+}
+
+func (e *SchemaNotFoundError) Error() string {
+	return "No schema with key '" + e.Key + "' found!"
+}
+
+type TombstoneNotFoundError struct {
+	Key string
+}
+
+func (e *TombstoneNotFoundError) Error() string {
+	return "No deleted schema with key '" + e.Key + "' found!"
+}
+
+type SchemaPurgedError struct {
+	Key string
+}
+
+func (e *SchemaPurgedError) Error() string {
+	return "Schema with key '" + e.Key + "' has been purged and cannot be restored!"
+}
+
+type RetentionExpiredError struct {
+	Key          string
+	DeletionTime time.Time
+}
+
+func (e *RetentionExpiredError) Error() string {
+	return "Schema with key '" + e.Key + "' was deleted at " + e.DeletionTime.UTC().Format(time.RFC3339) + " and can no longer be restored!"
+}
+
+type VersionNotLatestError struct {
+	LatestVersion string
+}
+
+func (e *VersionNotLatestError) Error() string {
+	return "Provided version is not latest! Please provide a version that succeeds '" + e.LatestVersion + "'!"
+}
+
+type ReferenceNotFoundError struct {
+	Key string
+}
+
+func (e *ReferenceNotFoundError) Error() string {
+	return "Referenced schema '" + references.URI(e.Key) + "' not found!"
+}
+
+// A DraftReferenceError is returned when saving a schema which references a
+// draft. Drafts can still be overwritten, which would silently change the
+// referencing schema.
+type DraftReferenceError struct {
+	Key string
+}
+
+func (e *DraftReferenceError) Error() string {
+	return "Referenced schema '" + references.URI(e.Key) + "' is a draft! Publish it before referencing it."
+}
+
+type SelfReferenceError struct {
+	Key string
+}
+
+func (e *SelfReferenceError) Error() string {
+	return "Schema cannot reference itself!"
+}
+
+type SchemaReferencedError struct {
+	Key          string
+	ReferencedBy []string
+}
+
+func (e *SchemaReferencedError) Error() string {
+	return "Schema with key '" + e.Key + "' is still referenced by '" + strings.Join(e.ReferencedBy, "', '") + "'!"
+}
+
+type RevisionCompactedError struct {
+	CompactRevision int64
+}
+
+func (e *RevisionCompactedError) Error() string {
+	return "Requested revision has been compacted! Please resume from revision " + strconv.FormatInt(e.CompactRevision, 10) + " or later!"
+}
+
+func getSchemaDetailsFromKey(key string) *pb.ConfigSchemaDetails {
+	tokens := strings.Split(key, "/")
+	return &pb.ConfigSchemaDetails{
+		Namespace:  tokens[0],
+		SchemaName: tokens[1],
+		Version:    tokens[2],
 	}
-	schemaJson, err := yaml.YAMLToJSON([]byte(schema))
+}
+
+// isSchemaKey reports whether key names a schema version rather than
+// bookkeeping data such as latest version pointers.
+func isSchemaKey(key string) bool {
+	return !strings.HasPrefix(key, "/") && strings.Count(key, "/") == 2
+}
+
+func getSchemaPrefixFromKey(key string) string {
+	return key[:strings.LastIndex(key, "/")+1]
+}
+
+// Keys starting with "/" can never collide with schema keys, because
+// namespaces must be non-empty.
+func getLatestVersionKey(key string) string {
+	return "/latest/" + strings.TrimSuffix(getSchemaPrefixFromKey(key), "/")
+}
+
+// Reference keys form a reverse index from every referenced schema to the
+// schemas referencing it, so deletes can be refused without a full scan.
+func getReferenceKey(referenced string, referrer string) string {
+	return getReferencesPrefix(referenced) + referrer
+}
+
+func getReferencesPrefix(referenced string) string {
+	return "/references/" + referenced + "/"
+}
+
+// findReferences returns the keys of the schemas a schema saved under key
+// references. A schema cannot reference itself, which is only possible when
+// overwriting a draft.
+func findReferences(key string, schema string) ([]string, error) {
+	referencedKeys, err := references.Find(schema)
 	if err != nil {
-		return err
-	}
-	schemaData := &pb.ConfigSchemaData{
-		User:         user,
-		Schema:       string(schemaJson),
-		CreationTime: timestamppb.New(time.Now()),
+		return nil, err
 	}
-	serializedData, err := json.Marshal(schemaData)
-	if err != nil {
-		return err
+	for _, referenced := range referencedKeys {
+		if referenced == key {
+			return nil, &SelfReferenceError{Key: key}
+		}
 	}
-	_, err = repo.client.Put(ctx, key, string(serializedData))
-	return err
+	return referencedKeys, nil
 }
 
-func (repo *EtcdRepository) GetConfigSchema(key string) (*pb.ConfigSchemaData, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	resp, err := repo.client.Get(ctx, key)
-	cancel()
-	if err != nil {
+func getStoredReferences(value []byte) ([]string, error) {
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(value, &schemaData); err != nil {
 		return nil, err
 	}
-	if len(resp.Kvs) == 0 {
-		return nil, nil
-	}
+	return references.Find(schemaData.GetSchema())
+}
+
+const tombstonesPrefix = "/tombstones/"
+
+// Deleted schemas are moved to tombstone keys, which are taken into account
+// when checking that a saved version is the latest one, so deleted versions
+// can never be saved again.
+func getTombstoneKey(key string) string {
+	return tombstonesPrefix + key
+}
+
+func getTombstonesPrefix(prefix string) string {
+	return tombstonesPrefix + prefix
+}
+
+func getKeyFromTombstoneKey(tombstoneKey string) string {
+	return strings.TrimPrefix(tombstoneKey, tombstonesPrefix)
+}
+
+func encodeTombstone(value []byte, user *pb.User) ([]byte, error) {
 	var schemaData pb.ConfigSchemaData
-	if err := json.Unmarshal(resp.Kvs[0].Value, &schemaData); err != nil {
+	if err := json.Unmarshal(value, &schemaData); err != nil {
 		return nil, err
 	}
-	schemaYaml, err := yaml.JSONToYAML([]byte(schemaData.GetSchema()))
-	if err != nil {
+	schemaData.DeletedBy = user
+	schemaData.DeletionTime = timestamppb.New(time.Now())
+	return json.Marshal(&schemaData)
+}
+
+// restoreTombstone returns the stored schema data kept by a tombstone, or an
+// error if it can no longer be restored.
+func restoreTombstone(key string, value []byte, deletedAfter time.Time) ([]byte, error) {
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(value, &schemaData); err != nil {
 		return nil, err
 	}
-	schemaData.Schema = string(schemaYaml)
-	return &schemaData, nil
+	if schemaData.GetPurgeTime() != nil {
+		return nil, &SchemaPurgedError{Key: key}
+	}
+	if deletionTime := schemaData.GetDeletionTime().AsTime(); deletionTime.Before(deletedAfter) {
+		return nil, &RetentionExpiredError{Key: key, DeletionTime: deletionTime}
+	}
+	schemaData.DeletedBy = nil
+	schemaData.DeletionTime = nil
+	return json.Marshal(&schemaData)
 }
 
-func (repo *EtcdRepository) DeleteConfigSchema(key string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	res, err := repo.client.Delete(ctx, key)
-	if err != nil {
-		return err
+func purgeTombstone(value []byte) ([]byte, error) {
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(value, &schemaData); err != nil {
+		return nil, err
 	}
-	if res.Deleted > 0 {
-		return nil
+	if schemaData.GetPurgeTime() == nil {
+		schemaData.Schema = ""
+		schemaData.PurgeTime = timestamppb.New(time.Now())
 	}
-	return errors.New("No schema with key '" + key + "' found!")
+	return json.Marshal(&schemaData)
 }
 
-func (repo *EtcdRepository) GetSchemasByPrefix(prefix string) ([]*pb.ConfigSchema, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	res, err := repo.client.Get(ctx, prefix, clientv3.WithPrefix())
-	if err != nil {
+func decodeTombstone(tombstoneKey string, value []byte) (*pb.ConfigSchema, error) {
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(value, &schemaData); err != nil {
 		return nil, err
-	} else if res.Count == 0 {
-		return nil, nil
-	}
-	schemas := make([]*pb.ConfigSchema, res.Count)
-	for i, schemaKv := range res.Kvs {
-		schemaDetails := getSchemaDetailsFromKey(string(schemaKv.Key))
-		var schemaData pb.ConfigSchemaData
-		if err := json.Unmarshal(schemaKv.Value, &schemaData); err != nil {
-			return nil, err
-		}
+	}
+	// Purged tombstones no longer hold a schema.
+	if schemaData.GetSchema() != "" {
 		schemaYaml, err := yaml.JSONToYAML([]byte(schemaData.GetSchema()))
 		if err != nil {
 			return nil, err
 		}
 		schemaData.Schema = string(schemaYaml)
-		schemas[i] = &pb.ConfigSchema{
-			SchemaDetails: schemaDetails,
-			SchemaData:    &schemaData,
-		}
 	}
-	sort.Slice(schemas, func(i, j int) bool {
-		return semver.Compare(schemas[i].GetSchemaDetails().GetVersion(), schemas[j].GetSchemaDetails().GetVersion()) == -1
+	normalizeState(&schemaData)
+	return &pb.ConfigSchema{
+		SchemaDetails: getSchemaDetailsFromKey(getKeyFromTombstoneKey(tombstoneKey)),
+		SchemaData:    &schemaData,
+	}, nil
+}
+
+func getCompatibilityModeKey(prefix string) string {
+	return "/compatibility/" + prefix
+}
+
+func decodeCompatibilityMode(value []byte) pb.CompatibilityMode {
+	return pb.CompatibilityMode(pb.CompatibilityMode_value[string(value)])
+}
+
+const roleBindingsPrefix = "/rolebindings/"
+
+// Role bindings are keyed by username first, so the bindings of a user can be
+// read with a single prefix scan.
+func getRoleBindingKey(binding *pb.RoleBinding) string {
+	return roleBindingsPrefix + binding.GetUsername() + "/" + binding.GetNamespace()
+}
+
+func getRoleBindingsPrefix(username string) string {
+	if username == "" {
+		return roleBindingsPrefix
+	}
+	return roleBindingsPrefix + username + "/"
+}
+
+func decodeRoleBinding(key string, value []byte) *pb.RoleBinding {
+	tokens := strings.SplitN(strings.TrimPrefix(key, roleBindingsPrefix), "/", 2)
+	return &pb.RoleBinding{
+		Username:  tokens[0],
+		Namespace: tokens[1],
+		Role:      pb.Role(pb.Role_value[string(value)]),
+	}
+}
+
+func sortRoleBindings(bindings []*pb.RoleBinding) {
+	sort.Slice(bindings, func(i, j int) bool {
+		if bindings[i].GetNamespace() != bindings[j].GetNamespace() {
+			return bindings[i].GetNamespace() < bindings[j].GetNamespace()
+		}
+		return bindings[i].GetUsername() < bindings[j].GetUsername()
 	})
-	return schemas, nil
 }
 
-func (repo *EtcdRepository) GetLatestVersionByPrefix(prefix string) (string, error) {
-	schemas, err := repo.GetSchemasByPrefix(prefix)
+func checkVersionIsLatest(key string, latestVersion string) error {
+	version := getSchemaDetailsFromKey(key).GetVersion()
+	if latestVersion != "" && semver.Compare(version, latestVersion) != 1 {
+		return &VersionNotLatestError{LatestVersion: latestVersion}
+	}
+	return nil
+}
+
+func maxVersion(versions []string) string {
+	latest := ""
+	for _, version := range versions {
+		if latest == "" || semver.Compare(version, latest) == 1 {
+			latest = version
+		}
+	}
+	return latest
+}
+
+func encodeSchemaData(user *pb.User, schema string, state pb.LifecycleState) ([]byte, error) {
+	schemaJson, err := yaml.YAMLToJSON([]byte(schema))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	if len(schemas) == 0 {
-		return "", nil
+	schemaData := &pb.ConfigSchemaData{
+		User:         user,
+		Schema:       string(schemaJson),
+		CreationTime: timestamppb.New(time.Now()),
+		State:        state,
 	}
-	return schemas[len(schemas)-1].GetSchemaDetails().GetVersion(), nil
+	return json.Marshal(schemaData)
 }
 
-func getSchemaDetailsFromKey(key string) *pb.ConfigSchemaDetails {
-	tokens := strings.Split(key, "/")
-	return &pb.ConfigSchemaDetails{
-		Namespace:  tokens[0],
-		SchemaName: tokens[1],
-		Version:    tokens[2],
+func decodeSchemaMetadata(value []byte) (*pb.ConfigSchemaData, error) {
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(value, &schemaData); err != nil {
+		return nil, err
 	}
+	schemaData.Schema = ""
+	normalizeState(&schemaData)
+	return &schemaData, nil
+}
+
+func decodeSchemaData(value []byte) (*pb.ConfigSchemaData, error) {
+	var schemaData pb.ConfigSchemaData
+	if err := json.Unmarshal(value, &schemaData); err != nil {
+		return nil, err
+	}
+	schemaYaml, err := yaml.JSONToYAML([]byte(schemaData.GetSchema()))
+	if err != nil {
+		return nil, err
+	}
+	schemaData.Schema = string(schemaYaml)
+	normalizeState(&schemaData)
+	return &schemaData, nil
+}
+
+func sortSchemasByVersion(schemas []*pb.ConfigSchema) {
+	sort.Slice(schemas, func(i, j int) bool {
+		return semver.Compare(schemas[i].GetSchemaDetails().GetVersion(), schemas[j].GetSchemaDetails().GetVersion()) == -1
+	})
+}
+
+func sortSchemaDetails(schemaDetails []*pb.ConfigSchemaDetails) {
+	sort.Slice(schemaDetails, func(i, j int) bool {
+		a, b := schemaDetails[i], schemaDetails[j]
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		} else if a.GetSchemaName() != b.GetSchemaName() {
+			return a.GetSchemaName() < b.GetSchemaName()
+		}
+		return semver.Compare(a.GetVersion(), b.GetVersion()) == -1
+	})
 }
@@ -0,0 +1,869 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testUser = &pb.User{Username: "johndoe", Email: "johndoe@example.com"}
+
+const testSchema = "properties:\n  port:\n    type: integer\ntype: object\n"
+
+// backends lists every SchemaRepository implementation. Each test gets a
+// fresh, empty repository, which is closed when the test ends.
+var backends = []struct {
+	name string
+	open func(t *testing.T) SchemaRepository
+}{
+	{"memory", func(t *testing.T) SchemaRepository {
+		repo := NewMemoryRepository()
+		t.Cleanup(repo.Close)
+		return repo
+	}},
+	{"bolt", func(t *testing.T) SchemaRepository {
+		repo, err := NewBoltRepository(filepath.Join(t.TempDir(), "schemas.db"))
+		if err != nil {
+			t.Fatalf("Failed to open bolt repository: %v", err)
+		}
+		t.Cleanup(repo.Close)
+		return repo
+	}},
+	{"etcd", openEtcdRepository},
+}
+
+// openEtcdRepository connects to the etcd cluster named by ETCD_ENDPOINTS
+// (localhost:2379 by default) and skips the test if it is unavailable. Every
+// test stores its keys under a prefix of its own, which is removed afterwards.
+func openEtcdRepository(t *testing.T) SchemaRepository {
+	endpoints := os.Getenv("ETCD_ENDPOINTS")
+	if endpoints == "" {
+		endpoints = "localhost:2379"
+	}
+	repo, err := NewClient(EtcdConfig{
+		Endpoints:      strings.Split(endpoints, ","),
+		DialTimeout:    time.Second,
+		RequestTimeout: 5 * time.Second,
+		KeyPrefix:      fmt.Sprintf("/conformance-%d-%08x/", time.Now().UnixNano(), rand.Uint32()),
+	})
+	if err != nil {
+		t.Skipf("etcd is unavailable: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := repo.getClient().Get(ctx, "health"); err != nil {
+		repo.Close()
+		t.Skipf("etcd is unavailable: %v", err)
+	}
+	t.Cleanup(func() {
+		repo.getClient().Delete(context.Background(), "", clientv3.WithPrefix())
+		repo.Close()
+	})
+	return repo
+}
+
+func forEachBackend(t *testing.T, test func(t *testing.T, repo SchemaRepository)) {
+	for _, backend := range backends {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			test(t, backend.open(t))
+		})
+	}
+}
+
+func save(t *testing.T, repo SchemaRepository, key string, state pb.LifecycleState) {
+	t.Helper()
+	if err := repo.SaveConfigSchema(context.Background(), key, testUser, testSchema, state); err != nil {
+		t.Fatalf("Saving '%s' failed: %v", key, err)
+	}
+}
+
+func expectError(t *testing.T, err error, target interface{}) {
+	t.Helper()
+	if !errors.As(err, target) {
+		t.Fatalf("Got error %v, want %T", err, target)
+	}
+}
+
+func expectVersions(t *testing.T, schemas []*pb.ConfigSchema, want ...string) {
+	t.Helper()
+	got := make([]string, len(schemas))
+	for i, schema := range schemas {
+		got[i] = schema.GetSchemaDetails().GetVersion()
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("Got versions %v, want %v", got, want)
+	}
+}
+
+func expectState(t *testing.T, repo SchemaRepository, key string, state pb.LifecycleState, reason string) {
+	t.Helper()
+	metadata, err := repo.GetConfigSchemaMetadata(context.Background(), key)
+	if err != nil || metadata == nil {
+		t.Fatalf("Getting '%s' failed: %v", key, err)
+	}
+	if metadata.GetState() != state || metadata.GetStateReason() != reason {
+		t.Fatalf("Got state %v %q, want %v %q", metadata.GetState(), metadata.GetStateReason(), state, reason)
+	}
+}
+
+var conformanceTests = []struct {
+	name string
+	test func(t *testing.T, repo SchemaRepository)
+}{
+	{"SaveAndGet", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+		schemaData, err := repo.GetConfigSchema(ctx, "ns/s/v1.0.0")
+		if err != nil || schemaData == nil {
+			t.Fatalf("Getting schema failed: %v", err)
+		}
+		if schemaData.GetSchema() != testSchema || schemaData.GetUser().GetUsername() != testUser.GetUsername() || schemaData.GetCreationTime() == nil {
+			t.Fatalf("Got schema data %v", schemaData)
+		}
+		if schemaData, err := repo.GetConfigSchema(ctx, "ns/s/v2.0.0"); schemaData != nil || err != nil {
+			t.Fatalf("Got %v, %v for a missing schema, want nil, nil", schemaData, err)
+		}
+		metadata, err := repo.GetConfigSchemaMetadata(ctx, "ns/s/v1.0.0")
+		if err != nil || metadata.GetSchema() != "" || metadata.GetUser().GetUsername() != testUser.GetUsername() || metadata.GetCreationTime() == nil {
+			t.Fatalf("Got metadata %v, %v", metadata, err)
+		}
+		if metadata, err := repo.GetConfigSchemaMetadata(ctx, "ns/s/v2.0.0"); metadata != nil || err != nil {
+			t.Fatalf("Got %v, %v for missing metadata, want nil, nil", metadata, err)
+		}
+		if err := repo.SaveConfigSchema(ctx, "ns/s/v1.0.0", testUser, testSchema, pb.LifecycleState_PUBLISHED); err == nil {
+			t.Fatal("Saving an existing key succeeded")
+		}
+	}},
+	{"SaveRequiresLatestVersion", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		save(t, repo, "ns/s/v1.9.0", pb.LifecycleState_PUBLISHED)
+		save(t, repo, "ns/s/v1.10.0", pb.LifecycleState_PUBLISHED)
+		for _, key := range []string{"ns/s/v1.10.0", "ns/s/v1.9.1", "ns/s/v1.0.0"} {
+			var notLatestErr *VersionNotLatestError
+			expectError(t, repo.SaveConfigSchema(ctx, key, testUser, testSchema, pb.LifecycleState_PUBLISHED), &notLatestErr)
+			if notLatestErr.LatestVersion != "v1.10.0" {
+				t.Fatalf("Got latest version %s, want v1.10.0", notLatestErr.LatestVersion)
+			}
+		}
+		// Other schemas are independent.
+		save(t, repo, "ns/other/v1.0.0", pb.LifecycleState_PUBLISHED)
+	}},
+	{"ListingsAreSortedBySemVer", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		for _, key := range []string{"ns/s/v1.2.0", "ns/s/v1.9.0", "ns/s/v1.10.0", "ns/r/v2.0.0", "a/b/v0.1.0", "-x/b/v1.0.0", "ns/gone/v1.0.0"} {
+			save(t, repo, key, pb.LifecycleState_PUBLISHED)
+		}
+		// Bookkeeping keys are left out of listings.
+		if err := repo.SetCompatibilityMode(ctx, "ns/s", pb.CompatibilityMode_BACKWARD); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.DeleteConfigSchema(ctx, "ns/gone/v1.0.0", testUser); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.SetRoleBinding(ctx, &pb.RoleBinding{Username: "johndoe", Namespace: "ns", Role: pb.Role_READER}); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.AppendAuditEvent(ctx, &pb.AuditEvent{Method: "SaveConfigSchema", User: testUser}); err != nil {
+			t.Fatal(err)
+		}
+		schemas, err := repo.GetSchemasByPrefix(ctx, "ns/s/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectVersions(t, schemas, "v1.2.0", "v1.9.0", "v1.10.0")
+		schemas, err = repo.GetSchemaMetadataByPrefix(ctx, "ns/s/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectVersions(t, schemas, "v1.2.0", "v1.9.0", "v1.10.0")
+		if schemaData := schemas[0].GetSchemaData(); schemaData.GetSchema() != "" || schemaData.GetCreationTime() == nil {
+			t.Fatalf("Got metadata %v, want it without the schema", schemaData)
+		}
+		schemaDetails, err := repo.GetSchemaDetailsByPrefix(ctx, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, details := range schemaDetails {
+			keys = append(keys, details.GetNamespace()+"/"+details.GetSchemaName()+"/"+details.GetVersion())
+		}
+		if strings.Join(keys, ",") != "-x/b/v1.0.0,a/b/v0.1.0,ns/r/v2.0.0,ns/s/v1.2.0,ns/s/v1.9.0,ns/s/v1.10.0" {
+			t.Fatalf("Got schema details %v", keys)
+		}
+		latest, err := repo.GetLatestVersionByPrefix(ctx, "ns/s/")
+		if err != nil || latest != "v1.10.0" {
+			t.Fatalf("Got latest version %q, %v, want v1.10.0", latest, err)
+		}
+		schemas, err = repo.GetSchemasByPrefix(ctx, "ns/missing/")
+		if err != nil || len(schemas) != 0 {
+			t.Fatalf("Got %v, %v for a missing prefix", schemas, err)
+		}
+		if latest, err := repo.GetLatestVersionByPrefix(ctx, "ns/missing/"); latest != "" || err != nil {
+			t.Fatalf("Got latest version %q, %v for a missing prefix", latest, err)
+		}
+	}},
+	{"References", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		referencing := "properties:\n  port:\n    $ref: quasar://common/port/v1.0.0#/definitions/port\n"
+		var referenceNotFoundErr *ReferenceNotFoundError
+		expectError(t, repo.SaveConfigSchema(ctx, "ns/s/v1.0.0", testUser, referencing, pb.LifecycleState_PUBLISHED), &referenceNotFoundErr)
+		if referenceNotFoundErr.Key != "common/port/v1.0.0" {
+			t.Fatalf("Got missing reference %s", referenceNotFoundErr.Key)
+		}
+		save(t, repo, "common/port/v1.0.0", pb.LifecycleState_PUBLISHED)
+		if err := repo.SaveConfigSchema(ctx, "ns/s/v1.0.0", testUser, referencing, pb.LifecycleState_PUBLISHED); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.SaveConfigSchema(ctx, "ns/r/v1.0.0", testUser, referencing, pb.LifecycleState_PUBLISHED); err != nil {
+			t.Fatal(err)
+		}
+		var referencedErr *SchemaReferencedError
+		expectError(t, repo.DeleteConfigSchema(ctx, "common/port/v1.0.0", testUser), &referencedErr)
+		if strings.Join(referencedErr.ReferencedBy, ",") != "ns/r/v1.0.0,ns/s/v1.0.0" {
+			t.Fatalf("Got referrers %v", referencedErr.ReferencedBy)
+		}
+		for _, key := range []string{"ns/s/v1.0.0", "ns/r/v1.0.0", "common/port/v1.0.0"} {
+			if err := repo.DeleteConfigSchema(ctx, key, testUser); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// The referencing schema cannot come back without its reference.
+		var restoreErr *ReferenceNotFoundError
+		expectError(t, repo.RestoreConfigSchema(ctx, "ns/s/v1.0.0", time.Time{}), &restoreErr)
+		selfReferencing := "properties:\n  port:\n    $ref: quasar://ns/s/v2.0.0#/definitions/port\n"
+		var selfReferenceErr *SelfReferenceError
+		expectError(t, repo.SaveConfigSchema(ctx, "ns/s/v2.0.0", testUser, selfReferencing, pb.LifecycleState_PUBLISHED), &selfReferenceErr)
+		// Reference keys are bookkeeping data, not schemas.
+		schemaDetails, err := repo.GetSchemaDetailsByPrefix(ctx, "")
+		if err != nil || len(schemaDetails) != 0 {
+			t.Fatalf("Got %v, %v after deleting every schema", schemaDetails, err)
+		}
+	}},
+	{"Drafts", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		referencing := "properties:\n  port:\n    $ref: quasar://common/port/v1.0.0#/definitions/port\n"
+		save(t, repo, "common/port/v1.0.0", pb.LifecycleState_PUBLISHED)
+		if err := repo.SaveConfigSchema(ctx, "ns/s/v1.0.0", testUser, referencing, pb.LifecycleState_DRAFT); err != nil {
+			t.Fatal(err)
+		}
+		// Overwriting the draft drops the references it no longer makes.
+		save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_DRAFT)
+		if err := repo.DeleteConfigSchema(ctx, "common/port/v1.0.0", testUser); err != nil {
+			t.Fatal(err)
+		}
+		save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+		expectState(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED, "")
+		var notLatestErr *VersionNotLatestError
+		expectError(t, repo.SaveConfigSchema(ctx, "ns/s/v1.0.0", testUser, testSchema, pb.LifecycleState_DRAFT), &notLatestErr)
+		save(t, repo, "ns/s/v1.1.0", pb.LifecycleState_DRAFT)
+		save(t, repo, "ns/s/v1.2.0", pb.LifecycleState_DRAFT)
+		expectError(t, repo.SaveConfigSchema(ctx, "ns/s/v1.1.0", testUser, testSchema, pb.LifecycleState_DRAFT), &notLatestErr)
+		// Drafts can be saved again, so nothing may reference them.
+		var draftReferenceErr *DraftReferenceError
+		draftReferencing := "properties:\n  port:\n    $ref: quasar://ns/s/v1.2.0#/definitions/port\n"
+		expectError(t, repo.SaveConfigSchema(ctx, "ns/r/v1.0.0", testUser, draftReferencing, pb.LifecycleState_PUBLISHED), &draftReferenceErr)
+		if draftReferenceErr.Key != "ns/s/v1.2.0" {
+			t.Fatalf("Got draft reference %s", draftReferenceErr.Key)
+		}
+	}},
+	{"LifecycleTransitions", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		var notFoundErr *SchemaNotFoundError
+		expectError(t, repo.SetLifecycleState(ctx, "ns/s/v1.0.0", pb.LifecycleState_RETIRED, ""), &notFoundErr)
+		save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_DRAFT)
+		var transitionErr *InvalidTransitionError
+		expectError(t, repo.SetLifecycleState(ctx, "ns/s/v1.0.0", pb.LifecycleState_DEPRECATED, ""), &transitionErr)
+		steps := []pb.LifecycleState{
+			pb.LifecycleState_PUBLISHED,
+			pb.LifecycleState_DEPRECATED,
+			pb.LifecycleState_DEPRECATED,
+			pb.LifecycleState_PUBLISHED,
+			pb.LifecycleState_RETIRED,
+		}
+		for i, state := range steps {
+			reason := fmt.Sprintf("Step %d.", i)
+			if err := repo.SetLifecycleState(ctx, "ns/s/v1.0.0", state, reason); err != nil {
+				t.Fatal(err)
+			}
+			expectState(t, repo, "ns/s/v1.0.0", state, reason)
+		}
+		expectError(t, repo.SetLifecycleState(ctx, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED, ""), &transitionErr)
+		if transitionErr.From != pb.LifecycleState_RETIRED || transitionErr.To != pb.LifecycleState_PUBLISHED {
+			t.Fatalf("Got transition error %v", transitionErr)
+		}
+	}},
+	{"CompatibilityModes", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		if got, err := repo.GetCompatibilityMode(ctx, "ns/s"); got != pb.CompatibilityMode_COMPATIBILITY_MODE_UNSPECIFIED || err != nil {
+			t.Fatalf("Got compatibility mode %v, %v for an unconfigured schema", got, err)
+		}
+		for _, mode := range []pb.CompatibilityMode{pb.CompatibilityMode_FULL, pb.CompatibilityMode_COMPATIBILITY_MODE_UNSPECIFIED} {
+			if err := repo.SetCompatibilityMode(ctx, "ns/s", mode); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := repo.GetCompatibilityMode(ctx, "ns/s"); got != mode || err != nil {
+				t.Fatalf("Got compatibility mode %v, %v, want %v", got, err, mode)
+			}
+		}
+	}},
+	{"DeleteKeepsVersionReserved", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		var notFoundErr *SchemaNotFoundError
+		expectError(t, repo.DeleteConfigSchema(ctx, "ns/s/v1.0.0", testUser), &notFoundErr)
+		save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+		save(t, repo, "ns/s/v2.0.0", pb.LifecycleState_PUBLISHED)
+		if err := repo.DeleteConfigSchema(ctx, "ns/s/v2.0.0", testUser); err != nil {
+			t.Fatal(err)
+		}
+		if schemaData, err := repo.GetConfigSchema(ctx, "ns/s/v2.0.0"); schemaData != nil || err != nil {
+			t.Fatalf("Got %v, %v for a deleted schema", schemaData, err)
+		}
+		var notLatestErr *VersionNotLatestError
+		expectError(t, repo.SaveConfigSchema(ctx, "ns/s/v2.0.0", testUser, testSchema, pb.LifecycleState_PUBLISHED), &notLatestErr)
+		latest, err := repo.GetLatestVersionByPrefix(ctx, "ns/s/")
+		if err != nil || latest != "v2.0.0" {
+			t.Fatalf("Got latest version %q, %v, want v2.0.0", latest, err)
+		}
+		deleted, err := repo.GetDeletedSchemasByPrefix(ctx, "ns/s/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectVersions(t, deleted, "v2.0.0")
+		if deleted[0].GetSchemaData().GetDeletedBy().GetUsername() != testUser.GetUsername() || deleted[0].GetSchemaData().GetDeletionTime() == nil {
+			t.Fatalf("Got tombstone %v", deleted[0])
+		}
+		schemas, err := repo.GetSchemasByPrefix(ctx, "ns/s/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectVersions(t, schemas, "v1.0.0")
+	}},
+	{"RestoreAndPurge", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		var tombstoneNotFoundErr *TombstoneNotFoundError
+		expectError(t, repo.RestoreConfigSchema(ctx, "ns/s/v1.0.0", time.Time{}), &tombstoneNotFoundErr)
+		expectError(t, repo.PurgeConfigSchema(ctx, "ns/s/v1.0.0"), &tombstoneNotFoundErr)
+		save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+		if err := repo.SetLifecycleState(ctx, "ns/s/v1.0.0", pb.LifecycleState_DEPRECATED, "Use v2."); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.DeleteConfigSchema(ctx, "ns/s/v1.0.0", testUser); err != nil {
+			t.Fatal(err)
+		}
+		var retentionErr *RetentionExpiredError
+		expectError(t, repo.RestoreConfigSchema(ctx, "ns/s/v1.0.0", time.Now().Add(time.Hour)), &retentionErr)
+		if err := repo.RestoreConfigSchema(ctx, "ns/s/v1.0.0", time.Now().Add(-time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		expectState(t, repo, "ns/s/v1.0.0", pb.LifecycleState_DEPRECATED, "Use v2.")
+		if err := repo.DeleteConfigSchema(ctx, "ns/s/v1.0.0", testUser); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 2; i++ {
+			if err := repo.PurgeConfigSchema(ctx, "ns/s/v1.0.0"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var purgedErr *SchemaPurgedError
+		expectError(t, repo.RestoreConfigSchema(ctx, "ns/s/v1.0.0", time.Time{}), &purgedErr)
+		deleted, err := repo.GetDeletedSchemasByPrefix(ctx, "ns/s/")
+		if err != nil || len(deleted) != 1 || deleted[0].GetSchemaData().GetSchema() != "" || deleted[0].GetSchemaData().GetPurgeTime() == nil {
+			t.Fatalf("Got tombstones %v, %v", deleted, err)
+		}
+	}},
+	{"RoleBindings", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		bindings := []*pb.RoleBinding{
+			{Username: "johndoe", Namespace: "pay", Role: pb.Role_PUBLISHER},
+			{Username: "johndoe", Namespace: "*", Role: pb.Role_READER},
+			{Username: "janedoe", Namespace: "pay", Role: pb.Role_ADMIN},
+		}
+		for _, binding := range bindings {
+			if err := repo.SetRoleBinding(ctx, binding); err != nil {
+				t.Fatal(err)
+			}
+		}
+		listed, err := repo.ListRoleBindings(ctx, "johndoe")
+		if err != nil || len(listed) != 2 || listed[0].GetNamespace() != "*" || listed[1].GetRole() != pb.Role_PUBLISHER {
+			t.Fatalf("Got role bindings %v, %v", listed, err)
+		}
+		if err := repo.SetRoleBinding(ctx, &pb.RoleBinding{Username: "johndoe", Namespace: "pay"}); err != nil {
+			t.Fatal(err)
+		}
+		listed, err = repo.ListRoleBindings(ctx, "")
+		if err != nil || len(listed) != 2 || listed[0].GetUsername() != "johndoe" || listed[1].GetUsername() != "janedoe" {
+			t.Fatalf("Got role bindings %v, %v", listed, err)
+		}
+	}},
+	{"AuditEvents", func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		start := time.Now().Add(-time.Minute)
+		var ids []string
+		for i, namespace := range []string{"pay", "ops", "pay"} {
+			event := &pb.AuditEvent{
+				Time:       timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+				Method:     "SaveConfigSchema",
+				Namespaces: []string{namespace},
+				User:       testUser,
+			}
+			if err := repo.AppendAuditEvent(ctx, event); err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, event.GetId())
+		}
+		listIDs := func(filter AuditFilter) string {
+			events, err := repo.ListAuditEvents(ctx, filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var listed []string
+			for _, event := range events {
+				listed = append(listed, event.GetId())
+			}
+			return strings.Join(listed, ",")
+		}
+		cases := []struct {
+			filter AuditFilter
+			want   []string
+		}{
+			{AuditFilter{}, ids},
+			{AuditFilter{Namespace: "pay"}, []string{ids[0], ids[2]}},
+			{AuditFilter{Username: "janedoe"}, nil},
+			{AuditFilter{Limit: 2}, ids[:2]},
+			{AuditFilter{After: ids[0], Limit: 1}, ids[1:2]},
+			{AuditFilter{From: start.Add(time.Second), To: start.Add(2 * time.Second)}, ids[1:2]},
+		}
+		for _, c := range cases {
+			if got := listIDs(c.filter); got != strings.Join(c.want, ",") {
+				t.Fatalf("Got events %s for filter %+v, want %v", got, c.filter, c.want)
+			}
+		}
+	}},
+}
+
+func TestConformance(t *testing.T) {
+	for _, conformanceTest := range conformanceTests {
+		conformanceTest := conformanceTest
+		t.Run(conformanceTest.name, func(t *testing.T) {
+			forEachBackend(t, conformanceTest.test)
+		})
+	}
+}
+
+func TestBoltRepositoryPersistsSchemas(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schemas.db")
+	repo, err := NewBoltRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+	repo.Close()
+	repo, err = NewBoltRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	if schemaData, err := repo.GetConfigSchema(context.Background(), "ns/s/v1.0.0"); schemaData.GetSchema() != testSchema || err != nil {
+		t.Fatalf("Got %v, %v after reopening the database", schemaData, err)
+	}
+}
+
+// TestConcurrentSavesAndDeletes races saves of the next version of a schema
+// against each other and against deletes. Every version must be saved at most
+// once, and the latest version must be the highest version that remains,
+// counting deleted versions, which stay reserved.
+func TestConcurrentSavesAndDeletes(t *testing.T) {
+	const (
+		workers    = 8
+		iterations = 25
+		prefix     = "ns/s/"
+	)
+	forEachBackend(t, func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		var mu sync.Mutex
+		saved := make(map[string]bool)
+		deleted := make(map[string]bool)
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(seed int64) {
+				defer wg.Done()
+				random := rand.New(rand.NewSource(seed))
+				for i := 0; i < iterations; i++ {
+					latest, err := repo.GetLatestVersionByPrefix(ctx, prefix)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					var minor int
+					if latest != "" {
+						fmt.Sscanf(latest, "v1.%d.0", &minor)
+						minor++
+					}
+					version := fmt.Sprintf("v1.%d.0", minor)
+					err = repo.SaveConfigSchema(ctx, prefix+version, testUser, testSchema, pb.LifecycleState_PUBLISHED)
+					var notLatestErr *VersionNotLatestError
+					var existsErr *SchemaExistsError
+					mu.Lock()
+					switch {
+					case err == nil && saved[version]:
+						t.Errorf("Version %s was saved twice", version)
+					case err == nil:
+						saved[version] = true
+					case !errors.As(err, &notLatestErr) && !errors.As(err, &existsErr):
+						t.Errorf("Saving %s failed: %v", version, err)
+					}
+					var victim string
+					if random.Intn(3) == 0 {
+						for candidate := range saved {
+							victim = candidate
+							break
+						}
+					}
+					mu.Unlock()
+					if victim == "" {
+						continue
+					}
+					err = repo.DeleteConfigSchema(ctx, prefix+victim, testUser)
+					var notFoundErr *SchemaNotFoundError
+					if err == nil {
+						mu.Lock()
+						deleted[victim] = true
+						mu.Unlock()
+					} else if !errors.As(err, &notFoundErr) {
+						t.Errorf("Deleting %s failed: %v", victim, err)
+					}
+				}
+			}(int64(w))
+		}
+		wg.Wait()
+		live, err := repo.GetSchemasByPrefix(ctx, prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tombstones, err := repo.GetDeletedSchemasByPrefix(ctx, prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var remaining []string
+		for _, schema := range live {
+			version := schema.GetSchemaDetails().GetVersion()
+			if !saved[version] || deleted[version] {
+				t.Errorf("Version %s should not be stored", version)
+			}
+			remaining = append(remaining, version)
+		}
+		for _, schema := range tombstones {
+			version := schema.GetSchemaDetails().GetVersion()
+			if !deleted[version] {
+				t.Errorf("Version %s should not be deleted", version)
+			}
+			remaining = append(remaining, version)
+		}
+		if len(remaining) != len(saved) {
+			t.Errorf("Got %d stored versions, want %d", len(remaining), len(saved))
+		}
+		latest, err := repo.GetLatestVersionByPrefix(ctx, prefix)
+		if err != nil || latest != maxVersion(remaining) {
+			t.Errorf("Got latest version %q, %v, want %s", latest, err, maxVersion(remaining))
+		}
+		if etcdRepo, ok := repo.(*EtcdRepository); ok {
+			res, err := etcdRepo.getClient().Get(ctx, getLatestVersionKey(prefix+latest))
+			if err != nil || len(res.Kvs) != 1 || string(res.Kvs[0].Value) != maxVersion(remaining) {
+				t.Errorf("Got latest version pointer %v, %v, want %s", res, err, maxVersion(remaining))
+			}
+		}
+	})
+}
+
+// TestConcurrentDraftSavesAndDeletes races overwrites of a draft, which
+// alternately add and drop a reference, against a delete of the draft. The
+// tombstone must keep the last saved schema, and no reference made by the
+// deleted draft may be left behind.
+func TestConcurrentDraftSavesAndDeletes(t *testing.T) {
+	const (
+		iterations = 20
+		overwrites = 50
+	)
+	forEachBackend(t, func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		save(t, repo, "common/port/v1.0.0", pb.LifecycleState_PUBLISHED)
+		for i := 0; i < iterations; i++ {
+			key := fmt.Sprintf("ns/s%d/v1.0.0", i)
+			save(t, repo, key, pb.LifecycleState_DRAFT)
+			lastSaved := testSchema
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < overwrites; j++ {
+					schema := fmt.Sprintf("description: overwrite %d\n%s", j, testSchema)
+					if j%2 == 0 {
+						schema = fmt.Sprintf("description: overwrite %d\nproperties:\n  port:\n    $ref: quasar://common/port/v1.0.0#/definitions/port\n", j)
+					}
+					err := repo.SaveConfigSchema(ctx, key, testUser, schema, pb.LifecycleState_DRAFT)
+					var notLatestErr *VersionNotLatestError
+					var existsErr *SchemaExistsError
+					if errors.As(err, &notLatestErr) || errors.As(err, &existsErr) {
+						return
+					} else if err != nil {
+						t.Errorf("Saving %s failed: %v", key, err)
+						return
+					}
+					lastSaved = schema
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				time.Sleep(time.Duration(rand.Intn(2000)) * time.Microsecond)
+				if err := repo.DeleteConfigSchema(ctx, key, testUser); err != nil {
+					t.Errorf("Deleting %s failed: %v", key, err)
+				}
+			}()
+			wg.Wait()
+			deleted, err := repo.GetDeletedSchemasByPrefix(ctx, strings.TrimSuffix(key, "v1.0.0"))
+			if err != nil || len(deleted) != 1 {
+				t.Fatalf("Got tombstones %v, %v", deleted, err)
+			}
+			if deleted[0].GetSchemaData().GetSchema() != lastSaved {
+				t.Errorf("Tombstone of %s keeps %q, want %q", key, deleted[0].GetSchemaData().GetSchema(), lastSaved)
+			}
+		}
+		if err := repo.DeleteConfigSchema(ctx, "common/port/v1.0.0", testUser); err != nil {
+			t.Errorf("Deleting the referenced schema failed: %v", err)
+		}
+	})
+}
+
+// TestConcurrentLifecycleChangesAndDeletes races lifecycle changes of a schema
+// against its delete. The tombstone must keep the reason of the last change
+// which succeeded.
+func TestConcurrentLifecycleChangesAndDeletes(t *testing.T) {
+	const (
+		iterations = 20
+		changes    = 50
+	)
+	forEachBackend(t, func(t *testing.T, repo SchemaRepository) {
+		ctx := context.Background()
+		for i := 0; i < iterations; i++ {
+			key := fmt.Sprintf("ns/s%d/v1.0.0", i)
+			save(t, repo, key, pb.LifecycleState_PUBLISHED)
+			var lastReason string
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < changes; j++ {
+					reason := fmt.Sprintf("change %d", j)
+					err := repo.SetLifecycleState(ctx, key, pb.LifecycleState_DEPRECATED, reason)
+					var notFoundErr *SchemaNotFoundError
+					if errors.As(err, &notFoundErr) {
+						return
+					} else if err != nil {
+						t.Errorf("Deprecating %s failed: %v", key, err)
+						return
+					}
+					lastReason = reason
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				time.Sleep(time.Duration(rand.Intn(2000)) * time.Microsecond)
+				if err := repo.DeleteConfigSchema(ctx, key, testUser); err != nil {
+					t.Errorf("Deleting %s failed: %v", key, err)
+				}
+			}()
+			wg.Wait()
+			deleted, err := repo.GetDeletedSchemasByPrefix(ctx, strings.TrimSuffix(key, "v1.0.0"))
+			if err != nil || len(deleted) != 1 {
+				t.Fatalf("Got tombstones %v, %v", deleted, err)
+			}
+			if deleted[0].GetSchemaData().GetStateReason() != lastReason {
+				t.Errorf("Tombstone of %s keeps reason %q, want %q", key, deleted[0].GetSchemaData().GetStateReason(), lastReason)
+			}
+		}
+	})
+}
+
+func TestCanceledContextAbortsRequests(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, repo SchemaRepository) {
+		if _, ok := repo.(*MemoryRepository); ok {
+			t.Skip("The memory repository never blocks")
+		}
+		save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := repo.SaveConfigSchema(ctx, "ns/s/v2.0.0", testUser, testSchema, pb.LifecycleState_PUBLISHED); !errors.Is(err, context.Canceled) {
+			t.Errorf("Save with a canceled context: got %v", err)
+		}
+		if _, err := repo.GetConfigSchema(ctx, "ns/s/v1.0.0"); !errors.Is(err, context.Canceled) {
+			t.Errorf("Get with a canceled context: got %v", err)
+		}
+		if _, err := repo.GetSchemasByPrefix(ctx, "ns/s/"); !errors.Is(err, context.Canceled) {
+			t.Errorf("Listing with a canceled context: got %v", err)
+		}
+		if err := repo.DeleteConfigSchema(ctx, "ns/s/v1.0.0", testUser); !errors.Is(err, context.Canceled) {
+			t.Errorf("Delete with a canceled context: got %v", err)
+		}
+		if schemaData, err := repo.GetConfigSchema(context.Background(), "ns/s/v1.0.0"); schemaData == nil || err != nil {
+			t.Errorf("Got %v, %v, want the schema to be kept", schemaData, err)
+		}
+	})
+}
+
+func TestEtcdRejectsNonPositiveTimeouts(t *testing.T) {
+	for _, config := range []EtcdConfig{
+		{Endpoints: []string{"localhost:2379"}, RequestTimeout: time.Second},
+		{Endpoints: []string{"localhost:2379"}, DialTimeout: time.Second, RequestTimeout: -time.Second},
+	} {
+		if repo, err := NewClient(config); err == nil {
+			repo.Close()
+			t.Errorf("Got no error for dial timeout %v and request timeout %v", config.DialTimeout, config.RequestTimeout)
+		}
+	}
+}
+
+func TestEtcdHealthCheckReconnects(t *testing.T) {
+	repo := openEtcdRepository(t).(*EtcdRepository)
+	if err := repo.CheckHealth(); err != nil {
+		t.Fatalf("Health check of a reachable etcd failed: %v", err)
+	}
+	save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+	old := repo.getClient()
+	if err := repo.reconnect(); err != nil {
+		t.Fatal(err)
+	}
+	if repo.getClient() == old {
+		t.Fatal("Reconnecting kept the old client")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := old.Get(ctx, "health"); err == nil {
+		t.Error("The replaced client was not closed")
+	}
+	// The new client keeps the key prefix.
+	if schemaData, err := repo.GetConfigSchema(context.Background(), "ns/s/v1.0.0"); schemaData == nil || err != nil {
+		t.Fatalf("Got %v, %v after reconnecting", schemaData, err)
+	}
+}
+
+func TestEtcdHealthCheckReconnectsAfterConsecutiveFailures(t *testing.T) {
+	// Nothing listens on port 1, so every health check fails without etcd.
+	repo, err := NewClient(EtcdConfig{
+		Endpoints:      []string{"127.0.0.1:1"},
+		DialTimeout:    100 * time.Millisecond,
+		RequestTimeout: 100 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	old := repo.getClient()
+	for i := 1; i < reconnectAfterFailures; i++ {
+		if err := repo.CheckHealth(); err == nil {
+			t.Fatal("Health check of an unreachable etcd succeeded")
+		}
+		if repo.getClient() != old {
+			t.Fatalf("Reconnected after %d failed health checks, want %d", i, reconnectAfterFailures)
+		}
+	}
+	if err := repo.CheckHealth(); err == nil {
+		t.Fatal("Health check of an unreachable etcd succeeded")
+	}
+	if repo.getClient() == old {
+		t.Fatalf("Kept the client after %d failed health checks", reconnectAfterFailures)
+	}
+}
+
+func TestEtcdReconnectKeepsWatchedClientOpen(t *testing.T) {
+	repo := openEtcdRepository(t).(*EtcdRepository)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan *pb.SchemaEvent, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- repo.WatchSchemas(ctx, "ns/s/", 0, func(event *pb.SchemaEvent) error {
+			events <- event
+			return nil
+		})
+	}()
+	old := repo.getClient()
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		repo.mu.RLock()
+		watching := repo.watchers[old] > 0
+		repo.mu.RUnlock()
+		if watching {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatal("The watch did not start")
+		}
+	}
+	if err := repo.reconnect(); err != nil {
+		t.Fatal(err)
+	}
+	save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+	select {
+	case event := <-events:
+		if event.GetSchemaDetails().GetVersion() != "v1.0.0" {
+			t.Errorf("Got event %v", event)
+		}
+	case err := <-done:
+		t.Fatalf("The watch ended after reconnecting: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("The watch missed the event after reconnecting")
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Got %v, want the watch to be canceled", err)
+	}
+	getCtx, getCancel := context.WithTimeout(context.Background(), time.Second)
+	defer getCancel()
+	if _, err := old.Get(getCtx, "health"); err == nil {
+		t.Error("The replaced client was not closed after its last watch ended")
+	}
+}
+
+func TestWatchSchemasReportsDeletingUser(t *testing.T) {
+	repo := openEtcdRepository(t).(*EtcdRepository)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := repo.getClient().Get(ctx, "ns/s/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	save(t, repo, "ns/s/v1.0.0", pb.LifecycleState_PUBLISHED)
+	deletingUser := &pb.User{Username: "janedoe", Email: "janedoe@example.com"}
+	if err := repo.DeleteConfigSchema(ctx, "ns/s/v1.0.0", deletingUser); err != nil {
+		t.Fatal(err)
+	}
+	var events []*pb.SchemaEvent
+	errDone := errors.New("done")
+	err = repo.WatchSchemas(ctx, "ns/s/", res.Header.Revision+1, func(event *pb.SchemaEvent) error {
+		events = append(events, event)
+		if len(events) == 2 {
+			return errDone
+		}
+		return nil
+	})
+	if err != errDone {
+		t.Fatal(err)
+	}
+	if events[0].GetType() != pb.SchemaEventType_CREATED || events[0].GetUser().GetUsername() != testUser.GetUsername() {
+		t.Errorf("Got event %v, want a creation by %s", events[0], testUser.GetUsername())
+	}
+	if events[1].GetType() != pb.SchemaEventType_DELETED || events[1].GetUser().GetUsername() != deletingUser.GetUsername() {
+		t.Errorf("Got event %v, want a deletion by %s", events[1], deletingUser.GetUsername())
+	}
+}
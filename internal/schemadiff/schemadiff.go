@@ -0,0 +1,324 @@
+package schemadiff
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	PropertyAdded               = "property_added"
+	PropertyRemoved             = "property_removed"
+	RequiredAdded               = "required_added"
+	RequiredRemoved             = "required_removed"
+	TypeChanged                 = "type_changed"
+	EnumChanged                 = "enum_changed"
+	ConstraintChanged           = "constraint_changed"
+	AdditionalPropertiesChanged = "additional_properties_changed"
+	CompositionChanged          = "composition_changed"
+	ReferenceChanged            = "reference_changed"
+)
+
+// Change describes a single structural difference between two schemas.
+// A narrowing change may reject configurations the old schema accepted, so it
+// breaks backward compatibility. A widening change may accept configurations
+// the old schema rejected, so it breaks forward compatibility.
+type Change struct {
+	Path        string
+	Kind        string
+	Description string
+	Narrowing   bool
+	Widening    bool
+}
+
+var lowerBounds = []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"}
+
+var upperBounds = []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"}
+
+var exactConstraints = []string{"pattern", "format", "multipleOf", "const"}
+
+var compositions = []string{"allOf", "anyOf", "oneOf", "not", "if", "then", "else", "dependencies"}
+
+func Compare(oldSchema string, newSchema string) ([]Change, error) {
+	var oldDocument, newDocument interface{}
+	if err := yaml.Unmarshal([]byte(oldSchema), &oldDocument); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal([]byte(newSchema), &newDocument); err != nil {
+		return nil, err
+	}
+	var changes []Change
+	compareSchemas(&changes, nil, oldDocument, newDocument)
+	return changes, nil
+}
+
+func compareSchemas(changes *[]Change, path []string, oldSchema interface{}, newSchema interface{}) {
+	oldObject, newObject := asObject(oldSchema), asObject(newSchema)
+	if oldRef, newRef := oldObject["$ref"], newObject["$ref"]; !reflect.DeepEqual(oldRef, newRef) {
+		addChange(changes, path, ReferenceChanged, "Reference changed from "+describe(oldRef)+" to "+describe(newRef), true, true)
+	}
+	compareTypes(changes, path, oldObject["type"], newObject["type"])
+	compareEnums(changes, path, oldObject["enum"], newObject["enum"])
+	for _, keyword := range lowerBounds {
+		compareBound(changes, path, keyword, oldObject[keyword], newObject[keyword], true)
+	}
+	for _, keyword := range upperBounds {
+		compareBound(changes, path, keyword, oldObject[keyword], newObject[keyword], false)
+	}
+	for _, keyword := range exactConstraints {
+		compareExact(changes, path, keyword, oldObject[keyword], newObject[keyword])
+	}
+	if oldUnique, newUnique := oldObject["uniqueItems"] == true, newObject["uniqueItems"] == true; oldUnique != newUnique {
+		addChange(changes, append(path, "uniqueItems"), ConstraintChanged, "uniqueItems changed from "+strconv.FormatBool(oldUnique)+" to "+strconv.FormatBool(newUnique), newUnique, oldUnique)
+	}
+	for _, keyword := range compositions {
+		if !reflect.DeepEqual(oldObject[keyword], newObject[keyword]) {
+			addChange(changes, append(path, keyword), CompositionChanged, keyword+" changed", true, true)
+		}
+	}
+	compareRequired(changes, path, oldObject["required"], newObject["required"])
+	compareProperties(changes, path, asObject(oldObject["properties"]), asObject(newObject["properties"]))
+	compareAdditionalProperties(changes, path, oldObject["additionalProperties"], newObject["additionalProperties"])
+	compareItems(changes, path, oldObject["items"], newObject["items"])
+	for _, keyword := range []string{"definitions", "$defs"} {
+		compareDefinitions(changes, append(path, keyword), asObject(oldObject[keyword]), asObject(newObject[keyword]))
+	}
+}
+
+func compareTypes(changes *[]Change, path []string, oldType interface{}, newType interface{}) {
+	oldTypes, newTypes := typeSet(oldType), typeSet(newType)
+	narrowing, widening := !includesTypes(newTypes, oldTypes), !includesTypes(oldTypes, newTypes)
+	if narrowing || widening {
+		addChange(changes, append(path, "type"), TypeChanged, "Type changed from "+describe(oldType)+" to "+describe(newType), narrowing, widening)
+	}
+}
+
+// typeSet returns nil for schemas without a type, which accept any type.
+func typeSet(schemaType interface{}) map[string]bool {
+	switch t := schemaType.(type) {
+	case string:
+		return map[string]bool{t: true}
+	case []interface{}:
+		types := make(map[string]bool)
+		for _, item := range t {
+			if name, ok := item.(string); ok {
+				types[name] = true
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func includesTypes(superset map[string]bool, subset map[string]bool) bool {
+	if superset == nil {
+		return true
+	} else if subset == nil {
+		return false
+	}
+	for name := range subset {
+		if !superset[name] && !(name == "integer" && superset["number"]) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareEnums(changes *[]Change, path []string, oldEnum interface{}, newEnum interface{}) {
+	oldValues, newValues := valueSet(oldEnum), valueSet(newEnum)
+	var removed, added []string
+	if oldValues != nil && newValues != nil {
+		removed, added = difference(oldValues, newValues), difference(newValues, oldValues)
+	}
+	switch {
+	case oldValues == nil && newValues == nil:
+		return
+	case oldValues == nil:
+		addChange(changes, append(path, "enum"), EnumChanged, "Enum "+describe(newEnum)+" added", true, false)
+	case newValues == nil:
+		addChange(changes, append(path, "enum"), EnumChanged, "Enum "+describe(oldEnum)+" removed", false, true)
+	case len(removed) > 0 || len(added) > 0:
+		var descriptions []string
+		if len(removed) > 0 {
+			descriptions = append(descriptions, "values "+strings.Join(removed, ", ")+" removed")
+		}
+		if len(added) > 0 {
+			descriptions = append(descriptions, "values "+strings.Join(added, ", ")+" added")
+		}
+		addChange(changes, append(path, "enum"), EnumChanged, "Enum "+strings.Join(descriptions, " and "), len(removed) > 0, len(added) > 0)
+	}
+}
+
+func valueSet(enum interface{}) map[string]bool {
+	values, ok := enum.([]interface{})
+	if !ok {
+		return nil
+	}
+	set := make(map[string]bool)
+	for _, value := range values {
+		set[describe(value)] = true
+	}
+	return set
+}
+
+func difference(a map[string]bool, b map[string]bool) []string {
+	var result []string
+	for value := range a {
+		if !b[value] {
+			result = append(result, value)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func compareBound(changes *[]Change, path []string, keyword string, oldBound interface{}, newBound interface{}, isLower bool) {
+	oldValue, oldOk := oldBound.(float64)
+	newValue, newOk := newBound.(float64)
+	var narrowing, widening bool
+	switch {
+	case !oldOk && !newOk:
+		return
+	case !oldOk:
+		narrowing = true
+	case !newOk:
+		widening = true
+	case isLower:
+		narrowing, widening = newValue > oldValue, newValue < oldValue
+	default:
+		narrowing, widening = newValue < oldValue, newValue > oldValue
+	}
+	if narrowing || widening {
+		addChange(changes, append(path, keyword), ConstraintChanged, keyword+" changed from "+describe(oldBound)+" to "+describe(newBound), narrowing, widening)
+	}
+}
+
+func compareExact(changes *[]Change, path []string, keyword string, oldValue interface{}, newValue interface{}) {
+	if reflect.DeepEqual(oldValue, newValue) {
+		return
+	}
+	addChange(changes, append(path, keyword), ConstraintChanged, keyword+" changed from "+describe(oldValue)+" to "+describe(newValue), newValue != nil, oldValue != nil)
+}
+
+func compareRequired(changes *[]Change, path []string, oldRequired interface{}, newRequired interface{}) {
+	oldNames, newNames := valueSet(oldRequired), valueSet(newRequired)
+	for _, name := range difference(newNames, oldNames) {
+		addChange(changes, append(path, "required"), RequiredAdded, "Property "+name+" became required", true, false)
+	}
+	for _, name := range difference(oldNames, newNames) {
+		addChange(changes, append(path, "required"), RequiredRemoved, "Property "+name+" is no longer required", false, true)
+	}
+}
+
+func compareProperties(changes *[]Change, path []string, oldProperties map[string]interface{}, newProperties map[string]interface{}) {
+	for _, name := range sortedKeys(oldProperties, newProperties) {
+		propertyPath := append(append([]string(nil), path...), "properties", name)
+		oldProperty, inOld := oldProperties[name]
+		newProperty, inNew := newProperties[name]
+		switch {
+		case !inNew:
+			addChange(changes, propertyPath, PropertyRemoved, "Property "+describe(name)+" removed", true, false)
+		case !inOld:
+			addChange(changes, propertyPath, PropertyAdded, "Property "+describe(name)+" added", false, true)
+		default:
+			compareSchemas(changes, propertyPath, oldProperty, newProperty)
+		}
+	}
+}
+
+func compareDefinitions(changes *[]Change, path []string, oldDefinitions map[string]interface{}, newDefinitions map[string]interface{}) {
+	for _, name := range sortedKeys(oldDefinitions, newDefinitions) {
+		definitionPath := append(append([]string(nil), path...), name)
+		oldDefinition, inOld := oldDefinitions[name]
+		newDefinition, inNew := newDefinitions[name]
+		switch {
+		case !inNew:
+			addChange(changes, definitionPath, ReferenceChanged, "Definition "+describe(name)+" removed", true, true)
+		case inOld:
+			compareSchemas(changes, definitionPath, oldDefinition, newDefinition)
+		}
+	}
+}
+
+func compareAdditionalProperties(changes *[]Change, path []string, oldValue interface{}, newValue interface{}) {
+	path = append(path, "additionalProperties")
+	oldAllowed, newAllowed := oldValue != false, newValue != false
+	_, oldIsSchema := oldValue.(map[string]interface{})
+	_, newIsSchema := newValue.(map[string]interface{})
+	switch {
+	case oldIsSchema && newIsSchema:
+		compareSchemas(changes, path, oldValue, newValue)
+	case oldAllowed && !newAllowed, !oldIsSchema && oldAllowed && newIsSchema:
+		addChange(changes, path, AdditionalPropertiesChanged, "Additional properties restricted", true, false)
+	case !oldAllowed && newAllowed, oldIsSchema && !newIsSchema && newAllowed:
+		addChange(changes, path, AdditionalPropertiesChanged, "Additional properties relaxed", false, true)
+	}
+}
+
+func compareItems(changes *[]Change, path []string, oldItems interface{}, newItems interface{}) {
+	path = append(path, "items")
+	_, oldIsSchema := oldItems.(map[string]interface{})
+	_, newIsSchema := newItems.(map[string]interface{})
+	switch {
+	case oldItems == nil && newItems == nil:
+	case oldIsSchema && newIsSchema, oldItems == nil && newIsSchema, oldIsSchema && newItems == nil:
+		compareSchemas(changes, path, oldItems, newItems)
+	case !reflect.DeepEqual(oldItems, newItems):
+		addChange(changes, path, CompositionChanged, "items changed", true, true)
+	}
+}
+
+func addChange(changes *[]Change, path []string, kind string, description string, narrowing bool, widening bool) {
+	*changes = append(*changes, Change{
+		Path:        toPath(path),
+		Kind:        kind,
+		Description: description,
+		Narrowing:   narrowing,
+		Widening:    widening,
+	})
+}
+
+func toPath(tokens []string) string {
+	var path strings.Builder
+	path.WriteString("#")
+	for _, token := range tokens {
+		token = strings.ReplaceAll(token, "~", "~0")
+		token = strings.ReplaceAll(token, "/", "~1")
+		path.WriteString("/" + token)
+	}
+	return path.String()
+}
+
+func asObject(value interface{}) map[string]interface{} {
+	object, _ := value.(map[string]interface{})
+	return object
+}
+
+func sortedKeys(a map[string]interface{}, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func describe(value interface{}) string {
+	if value == nil {
+		return "none"
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "?"
+	}
+	return string(encoded)
+}
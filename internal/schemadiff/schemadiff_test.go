@@ -0,0 +1,108 @@
+package schemadiff
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	const base = `
+type: object
+required: [host]
+properties:
+  host:
+    type: string
+  port:
+    type: integer
+    minimum: 1
+    maximum: 65535
+  mode:
+    enum: [read, write]
+`
+	tests := []struct {
+		name      string
+		newSchema string
+		want      []Change
+	}{
+		{"unchanged", base, nil},
+		{"property removed", `
+type: object
+required: [host]
+properties:
+  host:
+    type: string
+  port:
+    type: integer
+    minimum: 1
+    maximum: 65535
+`, []Change{{Path: "#/properties/mode", Kind: PropertyRemoved, Description: `Property "mode" removed`, Narrowing: true}}},
+		{"property added and required", `
+type: object
+required: [host, user]
+properties:
+  host:
+    type: string
+  port:
+    type: integer
+    minimum: 1
+    maximum: 65535
+  mode:
+    enum: [read, write]
+  user:
+    type: string
+`, []Change{
+			{Path: "#/required", Kind: RequiredAdded, Description: `Property "user" became required`, Narrowing: true},
+			{Path: "#/properties/user", Kind: PropertyAdded, Description: `Property "user" added`, Widening: true},
+		}},
+		{"bounds and enum", `
+type: object
+required: [host]
+properties:
+  host:
+    type: string
+  port:
+    type: integer
+    minimum: 1024
+    maximum: 70000
+  mode:
+    enum: [read, write, admin]
+`, []Change{
+			{Path: "#/properties/mode/enum", Kind: EnumChanged, Description: `Enum values "admin" added`, Widening: true},
+			{Path: "#/properties/port/minimum", Kind: ConstraintChanged, Description: "minimum changed from 1 to 1024", Narrowing: true},
+			{Path: "#/properties/port/maximum", Kind: ConstraintChanged, Description: "maximum changed from 65535 to 70000", Widening: true},
+		}},
+		{"type widened", `
+type: object
+required: [host]
+properties:
+  host:
+    type: string
+  port:
+    type: number
+    minimum: 1
+    maximum: 65535
+  mode:
+    enum: [read, write]
+`, []Change{{Path: "#/properties/port/type", Kind: TypeChanged, Description: `Type changed from "integer" to "number"`, Widening: true}}},
+		{"additional properties restricted", base + "additionalProperties: false\n", []Change{
+			{Path: "#/additionalProperties", Kind: AdditionalPropertiesChanged, Description: "Additional properties restricted", Narrowing: true},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Compare(base, tt.newSchema)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(changes, tt.want) {
+				t.Errorf("Got changes\n%+v\nwant\n%+v", changes, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareRejectsInvalidYAML(t *testing.T) {
+	if _, err := Compare("type: object", "type: [object"); err == nil {
+		t.Error("invalid schema was accepted")
+	}
+}
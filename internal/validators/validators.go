@@ -1,62 +1,117 @@
 package validators
 
 import (
-	"errors"
+	"strconv"
 	"strings"
 
+	"github.com/jtomic1/config-schema-service/internal/references"
+	"github.com/jtomic1/config-schema-service/internal/versions"
 	pb "github.com/jtomic1/config-schema-service/proto"
 	"github.com/xeipuuv/gojsonschema"
 	"golang.org/x/mod/semver"
 	"sigs.k8s.io/yaml"
 )
 
+const (
+	MaxPageSize  = 1000
+	MaxBatchSize = 1000
+)
+
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+func newFieldError(field string, message string) error {
+	return &FieldError{
+		Field:   field,
+		Message: message,
+	}
+}
+
 func IsUserValid(user *pb.User) (bool, error) {
 	if user == nil {
-		return false, errors.New("User cannot be empty!")
+		return false, newFieldError("user", "User cannot be empty!")
 	} else if user.Email == "" {
-		return false, errors.New("User's email cannot be empty!")
+		return false, newFieldError("user.email", "User's email cannot be empty!")
 	} else if user.Username == "" {
-		return false, errors.New("User's username cannot be empty!")
+		return false, newFieldError("user.username", "User's username cannot be empty!")
 	}
 	return true, nil
 }
 
 func IsSchemaValid(schema string) (bool, error) {
+	return isSchemaValid("schema", schema)
+}
+
+func isSchemaValid(field string, schema string) (bool, error) {
 	if schema == "" {
-		return false, errors.New("Schema cannot be empty!")
+		return false, newFieldError(field, "Schema cannot be empty!")
 	}
 	schemaJson, err := yaml.YAMLToJSON([]byte(schema))
 	if err != nil {
-		return false, err
+		return false, newFieldError(field, err.Error())
+	}
+	referencedKeys, err := references.Find(string(schemaJson))
+	if err != nil {
+		return false, newFieldError(field, err.Error())
+	} else if len(referencedKeys) > 0 {
+		// Schemas referencing the registry can only be compiled once the
+		// referenced schemas are loaded, which is left to the server.
+		return true, nil
 	}
 	loader := gojsonschema.NewStringLoader(string(schemaJson))
 	_, schemaErr := gojsonschema.NewSchema(loader)
 	if schemaErr != nil {
-		return false, schemaErr
+		return false, newFieldError(field, schemaErr.Error())
 	}
 	return true, nil
 }
 
 func IsConfigurationValid(configuration string) (bool, error) {
 	if configuration == "" {
-		return false, errors.New("Configuration cannot be empty!")
+		return false, newFieldError("configuration", "Configuration cannot be empty!")
 	}
 	return true, nil
 }
 
-func AreSchemaDetailsValid(schemaDetails *pb.ConfigSchemaDetails, isVersionRequired bool) (bool, error) {
+type VersionRule int
+
+const (
+	VersionOptional VersionRule = iota
+	VersionExact
+	VersionSelector
+)
+
+func AreSchemaDetailsValid(schemaDetails *pb.ConfigSchemaDetails, versionRule VersionRule) (bool, error) {
+	return areSchemaDetailsValid("schema_details", schemaDetails, versionRule)
+}
+
+func areSchemaDetailsValid(field string, schemaDetails *pb.ConfigSchemaDetails, versionRule VersionRule) (bool, error) {
 	if schemaDetails == nil {
-		return false, errors.New("Schema details cannot be empty!")
+		return false, newFieldError(field, "Schema details cannot be empty!")
 	} else if schemaDetails.GetNamespace() == "" {
-		return false, errors.New("Schema namespace cannot be empty!")
+		return false, newFieldError(field+".namespace", "Schema namespace cannot be empty!")
 	} else if schemaDetails.GetSchemaName() == "" {
-		return false, errors.New("Schema name cannot be empty!")
-	} else if isVersionRequired && schemaDetails.GetVersion() == "" {
-		return false, errors.New("Schema version cannot be empty!")
-	} else if isVersionRequired && !semver.IsValid(schemaDetails.GetVersion()) {
-		return false, errors.New("Schema version must be a valid SemVer string with 'v' prefix!")
-	} else if strings.Contains(schemaDetails.GetNamespace(), "/") || strings.Contains(schemaDetails.GetSchemaName(), "/") || strings.Contains(schemaDetails.GetVersion(), "/") {
-		return false, errors.New("Schema details must not contain '/'!")
+		return false, newFieldError(field+".schema_name", "Schema name cannot be empty!")
+	} else if strings.Contains(schemaDetails.GetNamespace(), "/") {
+		return false, newFieldError(field+".namespace", "Schema details must not contain '/'!")
+	} else if strings.Contains(schemaDetails.GetSchemaName(), "/") {
+		return false, newFieldError(field+".schema_name", "Schema details must not contain '/'!")
+	} else if strings.Contains(schemaDetails.GetVersion(), "/") {
+		return false, newFieldError(field+".version", "Schema details must not contain '/'!")
+	} else if versionRule != VersionOptional && schemaDetails.GetVersion() == "" {
+		return false, newFieldError(field+".version", "Schema version cannot be empty!")
+	} else if versionRule == VersionExact && !semver.IsValid(schemaDetails.GetVersion()) {
+		return false, newFieldError(field+".version", "Schema version must be a valid SemVer string with 'v' prefix!")
+	} else if versionRule == VersionSelector && !versions.IsExact(schemaDetails.GetVersion()) {
+		if _, err := versions.ParseSelector(schemaDetails.GetVersion()); err != nil {
+			return false, newFieldError(field+".version", "Schema version must be a valid SemVer string with 'v' prefix, 'latest', 'latest-stable' or a version range!")
+		}
 	}
 	return true, nil
 }
@@ -66,7 +121,7 @@ func IsSaveSchemaRequestValid(saveRequest *pb.SaveConfigSchemaRequest) (bool, er
 	if userErr != nil {
 		return false, userErr
 	}
-	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(saveRequest.GetSchemaDetails(), true)
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(saveRequest.GetSchemaDetails(), VersionExact)
 	if schemaDetailsErr != nil {
 		return false, schemaDetailsErr
 	}
@@ -74,6 +129,13 @@ func IsSaveSchemaRequestValid(saveRequest *pb.SaveConfigSchemaRequest) (bool, er
 	if schemaErr != nil {
 		return false, schemaErr
 	}
+	referencedKeys, _ := references.Find(saveRequest.GetSchema())
+	details := saveRequest.GetSchemaDetails()
+	for _, referenced := range referencedKeys {
+		if referenced == details.GetNamespace()+"/"+details.GetSchemaName()+"/"+details.GetVersion() {
+			return false, newFieldError("schema", "Schema cannot reference itself!")
+		}
+	}
 	requestValid := userValid && schemaDetailsValid && schemaValid
 	return requestValid, nil
 }
@@ -83,10 +145,13 @@ func IsGetSchemaRequestValid(getRequest *pb.GetConfigSchemaRequest) (bool, error
 	if userErr != nil {
 		return false, userErr
 	}
-	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(getRequest.GetSchemaDetails(), true)
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(getRequest.GetSchemaDetails(), VersionSelector)
 	if schemaDetailsErr != nil {
 		return false, schemaDetailsErr
 	}
+	if getRequest.GetIncludeDeleted() && !versions.IsExact(getRequest.GetSchemaDetails().GetVersion()) {
+		return false, newFieldError("include_deleted", "Deleted schemas can only be retrieved by exact version!")
+	}
 
 	requestValid := userValid && schemaDetailsValid
 	return requestValid, nil
@@ -97,7 +162,7 @@ func IsDeleteSchemaRequestValid(deleteRequest *pb.DeleteConfigSchemaRequest) (bo
 	if userErr != nil {
 		return false, userErr
 	}
-	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(deleteRequest.GetSchemaDetails(), true)
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(deleteRequest.GetSchemaDetails(), VersionExact)
 	if schemaDetailsErr != nil {
 		return false, schemaDetailsErr
 	}
@@ -106,12 +171,64 @@ func IsDeleteSchemaRequestValid(deleteRequest *pb.DeleteConfigSchemaRequest) (bo
 	return requestValid, nil
 }
 
+func IsRestoreSchemaRequestValid(restoreRequest *pb.RestoreConfigSchemaRequest) (bool, error) {
+	userValid, userErr := IsUserValid(restoreRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(restoreRequest.GetSchemaDetails(), VersionExact)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
+
+	requestValid := userValid && schemaDetailsValid
+	return requestValid, nil
+}
+
+func IsPurgeSchemaRequestValid(purgeRequest *pb.PurgeConfigSchemaRequest) (bool, error) {
+	userValid, userErr := IsUserValid(purgeRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(purgeRequest.GetSchemaDetails(), VersionExact)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
+
+	requestValid := userValid && schemaDetailsValid
+	return requestValid, nil
+}
+
+func IsSetLifecycleStateRequestValid(setRequest *pb.SetLifecycleStateRequest) (bool, error) {
+	userValid, userErr := IsUserValid(setRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(setRequest.GetSchemaDetails(), VersionExact)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
+	stateValid, stateErr := isLifecycleStateValid("state", setRequest.GetState())
+	if stateErr != nil {
+		return false, stateErr
+	}
+	requestValid := userValid && schemaDetailsValid && stateValid
+	return requestValid, nil
+}
+
+func isLifecycleStateValid(field string, state pb.LifecycleState) (bool, error) {
+	if _, ok := pb.LifecycleState_name[int32(state)]; !ok || state == pb.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED {
+		return false, newFieldError(field, "Lifecycle state must be one of DRAFT, PUBLISHED, DEPRECATED or RETIRED!")
+	}
+	return true, nil
+}
+
 func IsValidateConfigurationRequestValid(validateRequest *pb.ValidateConfigurationRequest) (bool, error) {
 	userValid, userErr := IsUserValid(validateRequest.GetUser())
 	if userErr != nil {
 		return false, userErr
 	}
-	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(validateRequest.GetSchemaDetails(), true)
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(validateRequest.GetSchemaDetails(), VersionSelector)
 	if schemaDetailsErr != nil {
 		return false, schemaDetailsErr
 	}
@@ -128,11 +245,215 @@ func IsGetConfigSchemaVersionsValid(versionsRequest *pb.ConfigSchemaVersionsRequ
 	if userErr != nil {
 		return false, userErr
 	}
-	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(versionsRequest.GetSchemaDetails(), false)
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(versionsRequest.GetSchemaDetails(), VersionOptional)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
+	if versionsRequest.GetPageSize() < 0 || versionsRequest.GetPageSize() > MaxPageSize {
+		return false, newFieldError("page_size", "Page size must be between 0 and "+strconv.Itoa(MaxPageSize)+"!")
+	}
+	if _, err := versions.ParseRange(versionsRequest.GetVersionRange()); err != nil {
+		return false, newFieldError("version_range", err.Error())
+	}
+	for _, state := range versionsRequest.GetStates() {
+		if _, err := isLifecycleStateValid("states", state); err != nil {
+			return false, err
+		}
+	}
+
+	requestValid := userValid && schemaDetailsValid
+	return requestValid, nil
+}
+
+func IsNamespaceValid(namespace string) (bool, error) {
+	if namespace == "" {
+		return false, newFieldError("namespace", "Namespace cannot be empty!")
+	} else if strings.Contains(namespace, "/") {
+		return false, newFieldError("namespace", "Namespace must not contain '/'!")
+	}
+	return true, nil
+}
+
+func IsListNamespacesRequestValid(listRequest *pb.ListNamespacesRequest) (bool, error) {
+	return IsUserValid(listRequest.GetUser())
+}
+
+func IsListSchemasRequestValid(listRequest *pb.ListSchemasRequest) (bool, error) {
+	userValid, userErr := IsUserValid(listRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	namespaceValid, namespaceErr := IsNamespaceValid(listRequest.GetNamespace())
+	if namespaceErr != nil {
+		return false, namespaceErr
+	}
+	requestValid := userValid && namespaceValid
+	return requestValid, nil
+}
+
+func IsCompatibilityModeValid(mode pb.CompatibilityMode) (bool, error) {
+	if _, ok := pb.CompatibilityMode_name[int32(mode)]; !ok {
+		return false, newFieldError("mode", "Compatibility mode is unknown!")
+	}
+	return true, nil
+}
+
+func IsSetCompatibilityModeRequestValid(setRequest *pb.SetCompatibilityModeRequest) (bool, error) {
+	userValid, userErr := IsUserValid(setRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(setRequest.GetSchemaDetails(), VersionOptional)
 	if schemaDetailsErr != nil {
 		return false, schemaDetailsErr
 	}
+	modeValid, modeErr := IsCompatibilityModeValid(setRequest.GetMode())
+	if modeErr != nil {
+		return false, modeErr
+	}
+	requestValid := userValid && schemaDetailsValid && modeValid
+	return requestValid, nil
+}
 
+func IsGetCompatibilityModeRequestValid(getRequest *pb.GetCompatibilityModeRequest) (bool, error) {
+	userValid, userErr := IsUserValid(getRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(getRequest.GetSchemaDetails(), VersionOptional)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
 	requestValid := userValid && schemaDetailsValid
 	return requestValid, nil
 }
+
+func IsSuggestNextVersionRequestValid(suggestRequest *pb.SuggestNextVersionRequest) (bool, error) {
+	userValid, userErr := IsUserValid(suggestRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(suggestRequest.GetSchemaDetails(), VersionOptional)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
+	schemaValid, schemaErr := IsSchemaValid(suggestRequest.GetSchema())
+	if schemaErr != nil {
+		return false, schemaErr
+	}
+	requestValid := userValid && schemaDetailsValid && schemaValid
+	return requestValid, nil
+}
+
+func IsDiffConfigSchemasRequestValid(diffRequest *pb.DiffConfigSchemasRequest) (bool, error) {
+	userValid, userErr := IsUserValid(diffRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	baseValid, baseErr := areSchemaDetailsValid("base_details", diffRequest.GetBaseDetails(), VersionSelector)
+	if baseErr != nil {
+		return false, baseErr
+	}
+	if diffRequest.GetTargetDetails() != nil && diffRequest.GetCandidateSchema() != "" {
+		return false, newFieldError("candidate_schema", "Only one of target details and candidate schema can be provided!")
+	} else if diffRequest.GetTargetDetails() == nil && diffRequest.GetCandidateSchema() == "" {
+		return false, newFieldError("target_details", "Either target details or candidate schema must be provided!")
+	}
+	var targetValid bool
+	var targetErr error
+	if diffRequest.GetTargetDetails() != nil {
+		targetValid, targetErr = areSchemaDetailsValid("target_details", diffRequest.GetTargetDetails(), VersionSelector)
+	} else {
+		targetValid, targetErr = isSchemaValid("candidate_schema", diffRequest.GetCandidateSchema())
+	}
+	if targetErr != nil {
+		return false, targetErr
+	}
+	requestValid := userValid && baseValid && targetValid
+	return requestValid, nil
+}
+
+func IsValidateConfigurationsRequestValid(validateRequest *pb.ValidateConfigurationsRequest) (bool, error) {
+	userValid, userErr := IsUserValid(validateRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	if len(validateRequest.GetItems()) == 0 {
+		return false, newFieldError("items", "Items cannot be empty!")
+	} else if len(validateRequest.GetItems()) > MaxBatchSize {
+		return false, newFieldError("items", "At most "+strconv.Itoa(MaxBatchSize)+" items can be validated at once!")
+	}
+	return userValid, nil
+}
+
+func IsWatchConfigSchemasRequestValid(watchRequest *pb.WatchConfigSchemasRequest) (bool, error) {
+	userValid, userErr := IsUserValid(watchRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	schemaDetailsValid, schemaDetailsErr := AreSchemaDetailsValid(watchRequest.GetSchemaDetails(), VersionOptional)
+	if schemaDetailsErr != nil {
+		return false, schemaDetailsErr
+	}
+	if watchRequest.GetStartRevision() < 0 {
+		return false, newFieldError("start_revision", "Start revision cannot be negative!")
+	}
+	requestValid := userValid && schemaDetailsValid
+	return requestValid, nil
+}
+
+func IsRoleBindingValid(binding *pb.RoleBinding) (bool, error) {
+	if binding == nil {
+		return false, newFieldError("binding", "Role binding cannot be empty!")
+	} else if binding.GetUsername() == "" {
+		return false, newFieldError("binding.username", "Username cannot be empty!")
+	} else if strings.Contains(binding.GetUsername(), "/") {
+		return false, newFieldError("binding.username", "Username must not contain '/'!")
+	} else if binding.GetNamespace() == "" {
+		return false, newFieldError("binding.namespace", "Namespace cannot be empty!")
+	} else if strings.Contains(binding.GetNamespace(), "/") {
+		return false, newFieldError("binding.namespace", "Namespace must not contain '/'!")
+	} else if _, ok := pb.Role_name[int32(binding.GetRole())]; !ok {
+		return false, newFieldError("binding.role", "Role is unknown!")
+	}
+	return true, nil
+}
+
+func IsSetRoleBindingRequestValid(setRequest *pb.SetRoleBindingRequest) (bool, error) {
+	userValid, userErr := IsUserValid(setRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	bindingValid, bindingErr := IsRoleBindingValid(setRequest.GetBinding())
+	if bindingErr != nil {
+		return false, bindingErr
+	}
+	requestValid := userValid && bindingValid
+	return requestValid, nil
+}
+
+func IsListRoleBindingsRequestValid(listRequest *pb.ListRoleBindingsRequest) (bool, error) {
+	userValid, userErr := IsUserValid(listRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	if strings.Contains(listRequest.GetNamespace(), "/") {
+		return false, newFieldError("namespace", "Namespace must not contain '/'!")
+	}
+	return userValid, nil
+}
+
+func IsListAuditEventsRequestValid(listRequest *pb.ListAuditEventsRequest) (bool, error) {
+	userValid, userErr := IsUserValid(listRequest.GetUser())
+	if userErr != nil {
+		return false, userErr
+	}
+	if strings.Contains(listRequest.GetNamespace(), "/") {
+		return false, newFieldError("namespace", "Namespace must not contain '/'!")
+	} else if listRequest.GetPageSize() < 0 || listRequest.GetPageSize() > MaxPageSize {
+		return false, newFieldError("page_size", "Page size must be between 0 and "+strconv.Itoa(MaxPageSize)+"!")
+	} else if listRequest.GetStartTime() != nil && listRequest.GetEndTime() != nil && !listRequest.GetStartTime().AsTime().Before(listRequest.GetEndTime().AsTime()) {
+		return false, newFieldError("end_time", "End time must be after start time!")
+	}
+	return userValid, nil
+}
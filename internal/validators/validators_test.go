@@ -0,0 +1,68 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/jtomic1/config-schema-service/proto"
+)
+
+func TestAreSchemaDetailsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		details *pb.ConfigSchemaDetails
+		rule    VersionRule
+		field   string
+	}{
+		{"exact version", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s", Version: "v1.0.0"}, VersionExact, ""},
+		{"selector", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s", Version: "latest"}, VersionSelector, ""},
+		{"range", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s", Version: "^v1.0.0"}, VersionSelector, ""},
+		{"no version", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s"}, VersionOptional, ""},
+		{"missing details", nil, VersionExact, "schema_details"},
+		{"empty namespace", &pb.ConfigSchemaDetails{SchemaName: "s", Version: "v1.0.0"}, VersionExact, "schema_details.namespace"},
+		{"empty version", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s"}, VersionExact, "schema_details.version"},
+		{"invalid version", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s", Version: "1.0.0"}, VersionExact, "schema_details.version"},
+		{"range as exact version", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s", Version: "^v1.0.0"}, VersionExact, "schema_details.version"},
+		{"invalid selector", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s", Version: "newest"}, VersionSelector, "schema_details.version"},
+		{"slash in namespace", &pb.ConfigSchemaDetails{Namespace: "a/b", SchemaName: "s", Version: "v1.0.0"}, VersionExact, "schema_details.namespace"},
+		{"slash in name", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "a/b", Version: "v1.0.0"}, VersionExact, "schema_details.schema_name"},
+		{"slash in namespace with selector", &pb.ConfigSchemaDetails{Namespace: "a/b", SchemaName: "s", Version: "latest"}, VersionSelector, "schema_details.namespace"},
+		{"slash in name with range", &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "a/b", Version: "^v1.0.0"}, VersionSelector, "schema_details.schema_name"},
+		{"slash in namespace without version", &pb.ConfigSchemaDetails{Namespace: "a/b", SchemaName: "s"}, VersionOptional, "schema_details.namespace"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, err := AreSchemaDetailsValid(tt.details, tt.rule)
+			if tt.field == "" {
+				if !valid || err != nil {
+					t.Fatalf("got (%v, %v), want valid", valid, err)
+				}
+				return
+			}
+			var fieldErr *FieldError
+			if valid || !errors.As(err, &fieldErr) {
+				t.Fatalf("got (%v, %v), want a field error", valid, err)
+			}
+			if fieldErr.Field != tt.field {
+				t.Errorf("field = %q, want %q", fieldErr.Field, tt.field)
+			}
+		})
+	}
+}
+
+func TestIsSaveSchemaRequestValidRejectsSelfReference(t *testing.T) {
+	req := &pb.SaveConfigSchemaRequest{
+		User:          &pb.User{Username: "alice", Email: "alice@example.com"},
+		SchemaDetails: &pb.ConfigSchemaDetails{Namespace: "ns", SchemaName: "s", Version: "v1.0.0"},
+		Schema:        "definitions:\n  port:\n    type: integer\nproperties:\n  port:\n    $ref: quasar://ns/s/v1.0.0#/definitions/port\n",
+	}
+	_, err := IsSaveSchemaRequestValid(req)
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "schema" || fieldErr.Message != "Schema cannot reference itself!" {
+		t.Fatalf("got %v, want a self reference error", err)
+	}
+	req.SchemaDetails.Version = "v1.1.0"
+	if _, err := IsSaveSchemaRequestValid(req); err != nil {
+		t.Fatalf("reference to another version rejected: %v", err)
+	}
+}
@@ -0,0 +1,231 @@
+package versions
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+const (
+	Latest       = "latest"
+	LatestStable = "latest-stable"
+)
+
+type comparator struct {
+	operator string
+	version  string
+}
+
+// Range is a set of comparators which must all hold for a version to match,
+// e.g. ">=v1.2.0 <v2.0.0". The empty range matches every version.
+type Range struct {
+	comparators []comparator
+}
+
+var operators = []string{">=", "<=", ">", "<", "=", "^", "~"}
+
+func ParseRange(expression string) (Range, error) {
+	var versionRange Range
+	for _, token := range strings.Fields(expression) {
+		operator := "="
+		for _, op := range operators {
+			if strings.HasPrefix(token, op) {
+				operator = op
+				break
+			}
+		}
+		version := strings.TrimPrefix(token, operator)
+		if !semver.IsValid(version) {
+			return Range{}, errors.New("Version range contains an invalid SemVer string '" + version + "'!")
+		}
+		switch operator {
+		case "^", "~":
+			versionRange.comparators = append(versionRange.comparators,
+				comparator{operator: ">=", version: version},
+				comparator{operator: "<", version: upperBound(operator, version) + "-0"},
+			)
+		default:
+			versionRange.comparators = append(versionRange.comparators, comparator{
+				operator: operator,
+				version:  version,
+			})
+		}
+	}
+	return versionRange, nil
+}
+
+// upperBound returns the exclusive upper bound of a caret or tilde range.
+// ParseRange appends the lowest possible prerelease to it, so that
+// prereleases of the bound itself (e.g. v2.0.0-beta for ^v1.4) are excluded.
+// A caret allows changes that do not modify the left-most non-zero component
+// (^v1.4 means <v2.0.0, ^v0.4 means <v0.5.0), while a tilde allows patch
+// level changes if a minor version is given (~v2.1.0 means <v2.2.0) and minor
+// level changes otherwise (~v2 means <v3.0.0).
+func upperBound(operator string, version string) string {
+	major, minor, patch := parseCore(version)
+	components := strings.Count(strings.SplitN(version, "-", 2)[0], ".") + 1
+	switch {
+	case operator == "~" && components >= 2, operator == "^" && major == 0 && components >= 2 && (minor > 0 || components == 2):
+		return formatCore(major, minor+1, 0)
+	case operator == "^" && major == 0 && components == 3:
+		return formatCore(0, 0, patch+1)
+	default:
+		return formatCore(major+1, 0, 0)
+	}
+}
+
+func parseCore(version string) (int, int, int) {
+	core := strings.TrimPrefix(semver.Canonical(version), "v")
+	core = strings.SplitN(core, "-", 2)[0]
+	parts := strings.Split(core, ".")
+	major, _ := strconv.Atoi(parts[0])
+	minor, _ := strconv.Atoi(parts[1])
+	patch, _ := strconv.Atoi(parts[2])
+	return major, minor, patch
+}
+
+func formatCore(major int, minor int, patch int) string {
+	return "v" + strconv.Itoa(major) + "." + strconv.Itoa(minor) + "." + strconv.Itoa(patch)
+}
+
+func (r Range) Matches(version string) bool {
+	for _, c := range r.comparators {
+		cmp := semver.Compare(version, c.version)
+		var ok bool
+		switch c.operator {
+		case ">=":
+			ok = cmp >= 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case "<":
+			ok = cmp < 0
+		default:
+			ok = cmp == 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Selector picks the highest version out of a set of stored versions.
+// Prereleases are only selected by "latest" or by ranges which mention a
+// prerelease explicitly.
+type Selector struct {
+	versionRange     Range
+	allowPrereleases bool
+}
+
+func IsExact(version string) bool {
+	return semver.IsValid(version)
+}
+
+func ParseSelector(selector string) (Selector, error) {
+	switch selector {
+	case Latest:
+		return Selector{allowPrereleases: true}, nil
+	case LatestStable:
+		return Selector{}, nil
+	}
+	if strings.TrimSpace(selector) == "" {
+		return Selector{}, errors.New("Version selector cannot be empty!")
+	}
+	versionRange, err := ParseRange(selector)
+	if err != nil {
+		return Selector{}, err
+	}
+	return Selector{
+		versionRange:     versionRange,
+		allowPrereleases: strings.Contains(selector, "-"),
+	}, nil
+}
+
+func (s Selector) Resolve(versions []string) string {
+	resolved := ""
+	for _, version := range versions {
+		if !s.allowPrereleases && semver.Prerelease(version) != "" {
+			continue
+		}
+		if s.versionRange.Matches(version) && (resolved == "" || semver.Compare(version, resolved) == 1) {
+			resolved = version
+		}
+	}
+	return resolved
+}
+
+type Bump int
+
+const (
+	NoBump Bump = iota
+	Patch
+	Minor
+	Major
+)
+
+func (b Bump) String() string {
+	switch b {
+	case Patch:
+		return "patch"
+	case Minor:
+		return "minor"
+	case Major:
+		return "major"
+	default:
+		return "none"
+	}
+}
+
+// BumpBetween returns the most significant version component that changed
+// from one version to the next. A prerelease already counts as the bump it
+// leads up to, so v2.0.0-rc.1 to v2.0.0 is a major bump just like v1.4.0 to
+// v2.0.0-rc.1.
+func BumpBetween(from string, to string) Bump {
+	fromMajor, fromMinor, fromPatch := parseCore(from)
+	toMajor, toMinor, toPatch := parseCore(to)
+	switch {
+	case fromMajor != toMajor:
+		return Major
+	case fromMinor != toMinor:
+		return Minor
+	case fromPatch != toPatch:
+		return Patch
+	case semver.Prerelease(from) != "":
+		return prereleaseBump(from)
+	default:
+		return NoBump
+	}
+}
+
+func prereleaseBump(version string) Bump {
+	_, minor, patch := parseCore(version)
+	switch {
+	case minor == 0 && patch == 0:
+		return Major
+	case patch == 0:
+		return Minor
+	default:
+		return Patch
+	}
+}
+
+// Increment returns the lowest release version which succeeds version by the
+// given bump.
+func Increment(version string, bump Bump) string {
+	major, minor, patch := parseCore(version)
+	if semver.Prerelease(version) != "" && prereleaseBump(version) >= bump {
+		return formatCore(major, minor, patch)
+	}
+	switch bump {
+	case Major:
+		return formatCore(major+1, 0, 0)
+	case Minor:
+		return formatCore(major, minor+1, 0)
+	default:
+		return formatCore(major, minor, patch+1)
+	}
+}
@@ -0,0 +1,117 @@
+package versions
+
+import "testing"
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		expression string
+		matches    []string
+		rejects    []string
+	}{
+		{"", []string{"v0.0.1", "v1.0.0", "v2.0.0-rc.1"}, nil},
+		{"v1.2.0", []string{"v1.2.0"}, []string{"v1.2.1", "v1.1.0"}},
+		{"=v1.2.0", []string{"v1.2.0"}, []string{"v1.2.1"}},
+		{">=v1.2.0 <v2.0.0", []string{"v1.2.0", "v1.9.9"}, []string{"v1.1.9", "v2.0.0"}},
+		{">v1.2.0 <=v1.3.0", []string{"v1.2.1", "v1.3.0"}, []string{"v1.2.0", "v1.3.1"}},
+		{"^v1.4", []string{"v1.4.0", "v1.9.0"}, []string{"v1.3.9", "v2.0.0", "v2.0.0-beta"}},
+		{"^v0.4", []string{"v0.4.0", "v0.4.9"}, []string{"v0.5.0"}},
+		{"^v0.0.3", []string{"v0.0.3"}, []string{"v0.0.4"}},
+		{"~v2.1.0", []string{"v2.1.0", "v2.1.7"}, []string{"v2.2.0"}},
+		{"~v2", []string{"v2.0.0", "v2.9.0"}, []string{"v3.0.0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			versionRange, err := ParseRange(tt.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, version := range tt.matches {
+				if !versionRange.Matches(version) {
+					t.Errorf("%q does not match %s", tt.expression, version)
+				}
+			}
+			for _, version := range tt.rejects {
+				if versionRange.Matches(version) {
+					t.Errorf("%q matches %s", tt.expression, version)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRangeRejectsInvalidVersions(t *testing.T) {
+	for _, expression := range []string{"1.2.0", ">=v1.x", "^latest", ">=v1.0.0 <"} {
+		if _, err := ParseRange(expression); err == nil {
+			t.Errorf("%q was accepted", expression)
+		}
+	}
+}
+
+func TestSelectorResolve(t *testing.T) {
+	stored := []string{"v1.0.0", "v1.2.0", "v1.10.0", "v2.0.0-rc.1", "v0.9.0"}
+	tests := []struct {
+		selector string
+		want     string
+	}{
+		{Latest, "v2.0.0-rc.1"},
+		{LatestStable, "v1.10.0"},
+		{"^v1.0.0", "v1.10.0"},
+		{"~v1.2.0", "v1.2.0"},
+		{">=v2.0.0-0", "v2.0.0-rc.1"},
+		{">=v2.0.0", ""},
+		{"<v1.0.0", "v0.9.0"},
+	}
+	for _, tt := range tests {
+		selector, err := ParseSelector(tt.selector)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.selector, err)
+		}
+		if got := selector.Resolve(stored); got != tt.want {
+			t.Errorf("%q resolved to %q, want %q", tt.selector, got, tt.want)
+		}
+	}
+	if _, err := ParseSelector(" "); err == nil {
+		t.Error("empty selector was accepted")
+	}
+}
+
+func TestBumpBetween(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     Bump
+	}{
+		{"v1.0.0", "v1.0.1", Patch},
+		{"v1.0.0", "v1.1.0", Minor},
+		{"v1.4.0", "v2.0.0-rc.1", Major},
+		{"v2.0.0-rc.1", "v2.0.0", Major},
+		{"v1.1.0-rc.1", "v1.1.0", Minor},
+		{"v1.1.1-rc.1", "v1.1.1", Patch},
+		{"v1.0.0", "v1.0.0", NoBump},
+	}
+	for _, tt := range tests {
+		if got := BumpBetween(tt.from, tt.to); got != tt.want {
+			t.Errorf("BumpBetween(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestIncrement(t *testing.T) {
+	tests := []struct {
+		version string
+		bump    Bump
+		want    string
+	}{
+		{"v1.2.3", Patch, "v1.2.4"},
+		{"v1.2.3", Minor, "v1.3.0"},
+		{"v1.2.3", Major, "v2.0.0"},
+		{"v2.0.0-rc.1", Major, "v2.0.0"},
+		{"v2.0.0-rc.1", Patch, "v2.0.0"},
+		{"v1.3.0-rc.1", Major, "v2.0.0"},
+		{"v1", Minor, "v1.1.0"},
+	}
+	for _, tt := range tests {
+		if got := Increment(tt.version, tt.bump); got != tt.want {
+			t.Errorf("Increment(%s, %v) = %s, want %s", tt.version, tt.bump, got, tt.want)
+		}
+	}
+}
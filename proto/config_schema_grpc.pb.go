@@ -19,11 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ConfigSchemaService_SaveConfigSchema_FullMethodName        = "/configschema.ConfigSchemaService/SaveConfigSchema"
-	ConfigSchemaService_GetConfigSchema_FullMethodName         = "/configschema.ConfigSchemaService/GetConfigSchema"
-	ConfigSchemaService_DeleteConfigSchema_FullMethodName      = "/configschema.ConfigSchemaService/DeleteConfigSchema"
-	ConfigSchemaService_ValidateConfiguration_FullMethodName   = "/configschema.ConfigSchemaService/ValidateConfiguration"
-	ConfigSchemaService_GetConfigSchemaVersions_FullMethodName = "/configschema.ConfigSchemaService/GetConfigSchemaVersions"
+	ConfigSchemaService_SaveConfigSchema_FullMethodName            = "/configschema.ConfigSchemaService/SaveConfigSchema"
+	ConfigSchemaService_GetConfigSchema_FullMethodName             = "/configschema.ConfigSchemaService/GetConfigSchema"
+	ConfigSchemaService_DeleteConfigSchema_FullMethodName          = "/configschema.ConfigSchemaService/DeleteConfigSchema"
+	ConfigSchemaService_ValidateConfiguration_FullMethodName       = "/configschema.ConfigSchemaService/ValidateConfiguration"
+	ConfigSchemaService_GetConfigSchemaVersions_FullMethodName     = "/configschema.ConfigSchemaService/GetConfigSchemaVersions"
+	ConfigSchemaService_ListNamespaces_FullMethodName              = "/configschema.ConfigSchemaService/ListNamespaces"
+	ConfigSchemaService_ListSchemas_FullMethodName                 = "/configschema.ConfigSchemaService/ListSchemas"
+	ConfigSchemaService_SetCompatibilityMode_FullMethodName        = "/configschema.ConfigSchemaService/SetCompatibilityMode"
+	ConfigSchemaService_GetCompatibilityMode_FullMethodName        = "/configschema.ConfigSchemaService/GetCompatibilityMode"
+	ConfigSchemaService_SuggestNextVersion_FullMethodName          = "/configschema.ConfigSchemaService/SuggestNextVersion"
+	ConfigSchemaService_DiffConfigSchemas_FullMethodName           = "/configschema.ConfigSchemaService/DiffConfigSchemas"
+	ConfigSchemaService_ValidateConfigurations_FullMethodName      = "/configschema.ConfigSchemaService/ValidateConfigurations"
+	ConfigSchemaService_ValidateConfigurationStream_FullMethodName = "/configschema.ConfigSchemaService/ValidateConfigurationStream"
+	ConfigSchemaService_WatchConfigSchemas_FullMethodName          = "/configschema.ConfigSchemaService/WatchConfigSchemas"
+	ConfigSchemaService_SetRoleBinding_FullMethodName              = "/configschema.ConfigSchemaService/SetRoleBinding"
+	ConfigSchemaService_ListRoleBindings_FullMethodName            = "/configschema.ConfigSchemaService/ListRoleBindings"
+	ConfigSchemaService_ListAuditEvents_FullMethodName             = "/configschema.ConfigSchemaService/ListAuditEvents"
+	ConfigSchemaService_RestoreConfigSchema_FullMethodName         = "/configschema.ConfigSchemaService/RestoreConfigSchema"
+	ConfigSchemaService_PurgeConfigSchema_FullMethodName           = "/configschema.ConfigSchemaService/PurgeConfigSchema"
+	ConfigSchemaService_SetLifecycleState_FullMethodName           = "/configschema.ConfigSchemaService/SetLifecycleState"
 )
 
 // ConfigSchemaServiceClient is the client API for ConfigSchemaService service.
@@ -35,6 +50,21 @@ type ConfigSchemaServiceClient interface {
 	DeleteConfigSchema(ctx context.Context, in *DeleteConfigSchemaRequest, opts ...grpc.CallOption) (*DeleteConfigSchemaResponse, error)
 	ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
 	GetConfigSchemaVersions(ctx context.Context, in *ConfigSchemaVersionsRequest, opts ...grpc.CallOption) (*ConfigSchemaVersionsResponse, error)
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
+	SetCompatibilityMode(ctx context.Context, in *SetCompatibilityModeRequest, opts ...grpc.CallOption) (*SetCompatibilityModeResponse, error)
+	GetCompatibilityMode(ctx context.Context, in *GetCompatibilityModeRequest, opts ...grpc.CallOption) (*GetCompatibilityModeResponse, error)
+	SuggestNextVersion(ctx context.Context, in *SuggestNextVersionRequest, opts ...grpc.CallOption) (*SuggestNextVersionResponse, error)
+	DiffConfigSchemas(ctx context.Context, in *DiffConfigSchemasRequest, opts ...grpc.CallOption) (*DiffConfigSchemasResponse, error)
+	ValidateConfigurations(ctx context.Context, in *ValidateConfigurationsRequest, opts ...grpc.CallOption) (*ValidateConfigurationsResponse, error)
+	ValidateConfigurationStream(ctx context.Context, opts ...grpc.CallOption) (ConfigSchemaService_ValidateConfigurationStreamClient, error)
+	WatchConfigSchemas(ctx context.Context, in *WatchConfigSchemasRequest, opts ...grpc.CallOption) (ConfigSchemaService_WatchConfigSchemasClient, error)
+	SetRoleBinding(ctx context.Context, in *SetRoleBindingRequest, opts ...grpc.CallOption) (*SetRoleBindingResponse, error)
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	RestoreConfigSchema(ctx context.Context, in *RestoreConfigSchemaRequest, opts ...grpc.CallOption) (*RestoreConfigSchemaResponse, error)
+	PurgeConfigSchema(ctx context.Context, in *PurgeConfigSchemaRequest, opts ...grpc.CallOption) (*PurgeConfigSchemaResponse, error)
+	SetLifecycleState(ctx context.Context, in *SetLifecycleStateRequest, opts ...grpc.CallOption) (*SetLifecycleStateResponse, error)
 }
 
 type configSchemaServiceClient struct {
@@ -90,6 +120,186 @@ func (c *configSchemaServiceClient) GetConfigSchemaVersions(ctx context.Context,
 	return out, nil
 }
 
+func (c *configSchemaServiceClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_ListNamespaces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_ListSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) SetCompatibilityMode(ctx context.Context, in *SetCompatibilityModeRequest, opts ...grpc.CallOption) (*SetCompatibilityModeResponse, error) {
+	out := new(SetCompatibilityModeResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_SetCompatibilityMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) GetCompatibilityMode(ctx context.Context, in *GetCompatibilityModeRequest, opts ...grpc.CallOption) (*GetCompatibilityModeResponse, error) {
+	out := new(GetCompatibilityModeResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_GetCompatibilityMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) SuggestNextVersion(ctx context.Context, in *SuggestNextVersionRequest, opts ...grpc.CallOption) (*SuggestNextVersionResponse, error) {
+	out := new(SuggestNextVersionResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_SuggestNextVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) DiffConfigSchemas(ctx context.Context, in *DiffConfigSchemasRequest, opts ...grpc.CallOption) (*DiffConfigSchemasResponse, error) {
+	out := new(DiffConfigSchemasResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_DiffConfigSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) ValidateConfigurations(ctx context.Context, in *ValidateConfigurationsRequest, opts ...grpc.CallOption) (*ValidateConfigurationsResponse, error) {
+	out := new(ValidateConfigurationsResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_ValidateConfigurations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) ValidateConfigurationStream(ctx context.Context, opts ...grpc.CallOption) (ConfigSchemaService_ValidateConfigurationStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConfigSchemaService_ServiceDesc.Streams[0], ConfigSchemaService_ValidateConfigurationStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configSchemaServiceValidateConfigurationStreamClient{stream}
+	return x, nil
+}
+
+type ConfigSchemaService_ValidateConfigurationStreamClient interface {
+	Send(*ValidateConfigurationsRequest) error
+	Recv() (*ValidationResult, error)
+	grpc.ClientStream
+}
+
+type configSchemaServiceValidateConfigurationStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *configSchemaServiceValidateConfigurationStreamClient) Send(m *ValidateConfigurationsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *configSchemaServiceValidateConfigurationStreamClient) Recv() (*ValidationResult, error) {
+	m := new(ValidationResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *configSchemaServiceClient) WatchConfigSchemas(ctx context.Context, in *WatchConfigSchemasRequest, opts ...grpc.CallOption) (ConfigSchemaService_WatchConfigSchemasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConfigSchemaService_ServiceDesc.Streams[1], ConfigSchemaService_WatchConfigSchemas_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configSchemaServiceWatchConfigSchemasClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConfigSchemaService_WatchConfigSchemasClient interface {
+	Recv() (*SchemaEvent, error)
+	grpc.ClientStream
+}
+
+type configSchemaServiceWatchConfigSchemasClient struct {
+	grpc.ClientStream
+}
+
+func (x *configSchemaServiceWatchConfigSchemasClient) Recv() (*SchemaEvent, error) {
+	m := new(SchemaEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *configSchemaServiceClient) SetRoleBinding(ctx context.Context, in *SetRoleBindingRequest, opts ...grpc.CallOption) (*SetRoleBindingResponse, error) {
+	out := new(SetRoleBindingResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_SetRoleBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error) {
+	out := new(ListRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_ListRoleBindings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) RestoreConfigSchema(ctx context.Context, in *RestoreConfigSchemaRequest, opts ...grpc.CallOption) (*RestoreConfigSchemaResponse, error) {
+	out := new(RestoreConfigSchemaResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_RestoreConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) PurgeConfigSchema(ctx context.Context, in *PurgeConfigSchemaRequest, opts ...grpc.CallOption) (*PurgeConfigSchemaResponse, error) {
+	out := new(PurgeConfigSchemaResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_PurgeConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configSchemaServiceClient) SetLifecycleState(ctx context.Context, in *SetLifecycleStateRequest, opts ...grpc.CallOption) (*SetLifecycleStateResponse, error) {
+	out := new(SetLifecycleStateResponse)
+	err := c.cc.Invoke(ctx, ConfigSchemaService_SetLifecycleState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigSchemaServiceServer is the server API for ConfigSchemaService service.
 // All implementations must embed UnimplementedConfigSchemaServiceServer
 // for forward compatibility
@@ -99,6 +309,21 @@ type ConfigSchemaServiceServer interface {
 	DeleteConfigSchema(context.Context, *DeleteConfigSchemaRequest) (*DeleteConfigSchemaResponse, error)
 	ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error)
 	GetConfigSchemaVersions(context.Context, *ConfigSchemaVersionsRequest) (*ConfigSchemaVersionsResponse, error)
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error)
+	SetCompatibilityMode(context.Context, *SetCompatibilityModeRequest) (*SetCompatibilityModeResponse, error)
+	GetCompatibilityMode(context.Context, *GetCompatibilityModeRequest) (*GetCompatibilityModeResponse, error)
+	SuggestNextVersion(context.Context, *SuggestNextVersionRequest) (*SuggestNextVersionResponse, error)
+	DiffConfigSchemas(context.Context, *DiffConfigSchemasRequest) (*DiffConfigSchemasResponse, error)
+	ValidateConfigurations(context.Context, *ValidateConfigurationsRequest) (*ValidateConfigurationsResponse, error)
+	ValidateConfigurationStream(ConfigSchemaService_ValidateConfigurationStreamServer) error
+	WatchConfigSchemas(*WatchConfigSchemasRequest, ConfigSchemaService_WatchConfigSchemasServer) error
+	SetRoleBinding(context.Context, *SetRoleBindingRequest) (*SetRoleBindingResponse, error)
+	ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	RestoreConfigSchema(context.Context, *RestoreConfigSchemaRequest) (*RestoreConfigSchemaResponse, error)
+	PurgeConfigSchema(context.Context, *PurgeConfigSchemaRequest) (*PurgeConfigSchemaResponse, error)
+	SetLifecycleState(context.Context, *SetLifecycleStateRequest) (*SetLifecycleStateResponse, error)
 	mustEmbedUnimplementedConfigSchemaServiceServer()
 }
 
@@ -121,6 +346,51 @@ func (UnimplementedConfigSchemaServiceServer) ValidateConfiguration(context.Cont
 func (UnimplementedConfigSchemaServiceServer) GetConfigSchemaVersions(context.Context, *ConfigSchemaVersionsRequest) (*ConfigSchemaVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfigSchemaVersions not implemented")
 }
+func (UnimplementedConfigSchemaServiceServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemas not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) SetCompatibilityMode(context.Context, *SetCompatibilityModeRequest) (*SetCompatibilityModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCompatibilityMode not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) GetCompatibilityMode(context.Context, *GetCompatibilityModeRequest) (*GetCompatibilityModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompatibilityMode not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) SuggestNextVersion(context.Context, *SuggestNextVersionRequest) (*SuggestNextVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestNextVersion not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) DiffConfigSchemas(context.Context, *DiffConfigSchemasRequest) (*DiffConfigSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffConfigSchemas not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ValidateConfigurations(context.Context, *ValidateConfigurationsRequest) (*ValidateConfigurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfigurations not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ValidateConfigurationStream(ConfigSchemaService_ValidateConfigurationStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateConfigurationStream not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) WatchConfigSchemas(*WatchConfigSchemasRequest, ConfigSchemaService_WatchConfigSchemasServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfigSchemas not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) SetRoleBinding(context.Context, *SetRoleBindingRequest) (*SetRoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoleBinding not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) RestoreConfigSchema(context.Context, *RestoreConfigSchemaRequest) (*RestoreConfigSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConfigSchema not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) PurgeConfigSchema(context.Context, *PurgeConfigSchemaRequest) (*PurgeConfigSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeConfigSchema not implemented")
+}
+func (UnimplementedConfigSchemaServiceServer) SetLifecycleState(context.Context, *SetLifecycleStateRequest) (*SetLifecycleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLifecycleState not implemented")
+}
 func (UnimplementedConfigSchemaServiceServer) mustEmbedUnimplementedConfigSchemaServiceServer() {}
 
 // UnsafeConfigSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +494,287 @@ func _ConfigSchemaService_GetConfigSchemaVersions_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigSchemaService_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).ListSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_ListSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).ListSchemas(ctx, req.(*ListSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_SetCompatibilityMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompatibilityModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).SetCompatibilityMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_SetCompatibilityMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).SetCompatibilityMode(ctx, req.(*SetCompatibilityModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_GetCompatibilityMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompatibilityModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).GetCompatibilityMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_GetCompatibilityMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).GetCompatibilityMode(ctx, req.(*GetCompatibilityModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_SuggestNextVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestNextVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).SuggestNextVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_SuggestNextVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).SuggestNextVersion(ctx, req.(*SuggestNextVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_DiffConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffConfigSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).DiffConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_DiffConfigSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).DiffConfigSchemas(ctx, req.(*DiffConfigSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_ValidateConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigurationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).ValidateConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_ValidateConfigurations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).ValidateConfigurations(ctx, req.(*ValidateConfigurationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_ValidateConfigurationStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ConfigSchemaServiceServer).ValidateConfigurationStream(&configSchemaServiceValidateConfigurationStreamServer{stream})
+}
+
+type ConfigSchemaService_ValidateConfigurationStreamServer interface {
+	Send(*ValidationResult) error
+	Recv() (*ValidateConfigurationsRequest, error)
+	grpc.ServerStream
+}
+
+type configSchemaServiceValidateConfigurationStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *configSchemaServiceValidateConfigurationStreamServer) Send(m *ValidationResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *configSchemaServiceValidateConfigurationStreamServer) Recv() (*ValidateConfigurationsRequest, error) {
+	m := new(ValidateConfigurationsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ConfigSchemaService_WatchConfigSchemas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConfigSchemasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigSchemaServiceServer).WatchConfigSchemas(m, &configSchemaServiceWatchConfigSchemasServer{stream})
+}
+
+type ConfigSchemaService_WatchConfigSchemasServer interface {
+	Send(*SchemaEvent) error
+	grpc.ServerStream
+}
+
+type configSchemaServiceWatchConfigSchemasServer struct {
+	grpc.ServerStream
+}
+
+func (x *configSchemaServiceWatchConfigSchemasServer) Send(m *SchemaEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConfigSchemaService_SetRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).SetRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_SetRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).SetRoleBinding(ctx, req.(*SetRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).ListRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_ListRoleBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).ListRoleBindings(ctx, req.(*ListRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_RestoreConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreConfigSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).RestoreConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_RestoreConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).RestoreConfigSchema(ctx, req.(*RestoreConfigSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_PurgeConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeConfigSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).PurgeConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_PurgeConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).PurgeConfigSchema(ctx, req.(*PurgeConfigSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigSchemaService_SetLifecycleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLifecycleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigSchemaServiceServer).SetLifecycleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigSchemaService_SetLifecycleState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigSchemaServiceServer).SetLifecycleState(ctx, req.(*SetLifecycleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConfigSchemaService_ServiceDesc is the grpc.ServiceDesc for ConfigSchemaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,7 +802,71 @@ var ConfigSchemaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfigSchemaVersions",
 			Handler:    _ConfigSchemaService_GetConfigSchemaVersions_Handler,
 		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _ConfigSchemaService_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "ListSchemas",
+			Handler:    _ConfigSchemaService_ListSchemas_Handler,
+		},
+		{
+			MethodName: "SetCompatibilityMode",
+			Handler:    _ConfigSchemaService_SetCompatibilityMode_Handler,
+		},
+		{
+			MethodName: "GetCompatibilityMode",
+			Handler:    _ConfigSchemaService_GetCompatibilityMode_Handler,
+		},
+		{
+			MethodName: "SuggestNextVersion",
+			Handler:    _ConfigSchemaService_SuggestNextVersion_Handler,
+		},
+		{
+			MethodName: "DiffConfigSchemas",
+			Handler:    _ConfigSchemaService_DiffConfigSchemas_Handler,
+		},
+		{
+			MethodName: "ValidateConfigurations",
+			Handler:    _ConfigSchemaService_ValidateConfigurations_Handler,
+		},
+		{
+			MethodName: "SetRoleBinding",
+			Handler:    _ConfigSchemaService_SetRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListRoleBindings",
+			Handler:    _ConfigSchemaService_ListRoleBindings_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _ConfigSchemaService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "RestoreConfigSchema",
+			Handler:    _ConfigSchemaService_RestoreConfigSchema_Handler,
+		},
+		{
+			MethodName: "PurgeConfigSchema",
+			Handler:    _ConfigSchemaService_PurgeConfigSchema_Handler,
+		},
+		{
+			MethodName: "SetLifecycleState",
+			Handler:    _ConfigSchemaService_SetLifecycleState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidateConfigurationStream",
+			Handler:       _ConfigSchemaService_ValidateConfigurationStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchConfigSchemas",
+			Handler:       _ConfigSchemaService_WatchConfigSchemas_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "config_schema.proto",
 }